@@ -0,0 +1,110 @@
+// Command apono-export generates Terraform configuration and import blocks for the objects of an Apono tenant.
+//
+// It is used to bootstrap a Terraform repository for an existing tenant, and, given the state of an existing
+// repository, to find objects that were created outside of Terraform:
+//
+//	terraform state pull > state.json
+//	apono-export -exclude-state state.json -out unmanaged.tf
+//
+// The personal API token is read from the APONO_PERSONAL_TOKEN environment variable.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	v2client "github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/exporter"
+)
+
+// version can be set at build time with -ldflags "-X main.version=<version>".
+var version = "dev"
+
+func main() {
+	var (
+		endpoint      string
+		resourceTypes string
+		excludeState  string
+		out           string
+	)
+
+	flag.StringVar(&endpoint, "endpoint", envOrDefault("APONO_ENDPOINT", "https://api.apono.io"), "Apono API endpoint, defaults to APONO_ENDPOINT")
+	flag.StringVar(&resourceTypes, "types", "", "comma separated resource types to export, one or more of: "+strings.Join(exporter.ResourceTypes(), ", "))
+	flag.StringVar(&excludeState, "exclude-state", "", "path to a Terraform state file; objects managed in it are not exported")
+	flag.StringVar(&out, "out", "-", "path of the generated configuration file, or - for stdout")
+	flag.Parse()
+
+	if err := run(context.Background(), endpoint, resourceTypes, excludeState, out); err != nil {
+		log.Fatal(err.Error())
+	}
+}
+
+func run(ctx context.Context, endpoint, resourceTypes, excludeState, out string) error {
+	token := os.Getenv("APONO_PERSONAL_TOKEN")
+	if token == "" {
+		return fmt.Errorf("the APONO_PERSONAL_TOKEN environment variable is not set")
+	}
+
+	endpointURL, err := url.Parse(strings.TrimSpace(endpoint))
+	if err != nil || (endpointURL.Scheme != "http" && endpointURL.Scheme != "https") || endpointURL.Host == "" {
+		return fmt.Errorf("invalid endpoint %q, must be an absolute http(s) URL", endpoint)
+	}
+
+	// The exporter only reads from the tenant, so any write is rejected by the transport
+	httpClient := &http.Client{
+		Transport: &v2client.ReadOnlyTransport{
+			Transport: &v2client.UserAgentTransport{
+				UserAgent: fmt.Sprintf("apono-export/%s", version),
+				Transport: http.DefaultTransport,
+			},
+		},
+	}
+
+	apiClient, err := v2client.NewClient(
+		strings.TrimRight(endpointURL.String(), "/"),
+		v2client.NewTokenSecuritySource(token),
+		v2client.WithClient(httpClient),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create the Apono API client: %w", err)
+	}
+
+	opts := exporter.Options{}
+	if resourceTypes != "" {
+		for _, resourceType := range strings.Split(resourceTypes, ",") {
+			opts.ResourceTypes = append(opts.ResourceTypes, strings.TrimSpace(resourceType))
+		}
+	}
+
+	if excludeState != "" {
+		opts.ExcludeIDs, err = exporter.ReadManagedIDs(excludeState)
+		if err != nil {
+			return fmt.Errorf("failed to read the state file: %w", err)
+		}
+	}
+
+	config, err := exporter.Export(ctx, apiClient, opts)
+	if err != nil {
+		return err
+	}
+
+	if out == "-" {
+		_, err = os.Stdout.Write(config)
+		return err
+	}
+
+	return os.WriteFile(out, config, 0o644)
+}
+
+func envOrDefault(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
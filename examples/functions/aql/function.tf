@@ -0,0 +1,8 @@
+resource "apono_access_scope" "production_databases" {
+  name = "production databases"
+  query = join(" and ", [
+    provider::apono::aql("resource_type", "in", ["aws-rds-mysql-database", "aws-rds-postgresql-database"]),
+    provider::apono::aql("resource_tag[\"env\"]", "=", [var.environment]),
+    provider::apono::aql("resource_name", "not contains", ["test", "sandbox"]),
+  ])
+}
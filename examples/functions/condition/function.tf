@@ -0,0 +1,25 @@
+resource "apono_access_flow_v2" "example" {
+  name    = "Production database access"
+  trigger = "SELF_SERVE"
+
+  requestors = {
+    logical_operator = "OR"
+    conditions = [
+      provider::apono::condition("group", ["Engineering"], "is", "Okta Directory"),
+      provider::apono::condition("user", ["person@example.com"], null),
+    ]
+  }
+
+  approver_policy = {
+    approval_mode = "ANY_OF"
+    approver_groups = [
+      {
+        logical_operator = "OR"
+        approvers = [
+          provider::apono::condition("manager", [], null),
+        ]
+      }
+    ]
+  }
+  # ...
+}
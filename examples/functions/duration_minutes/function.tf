@@ -0,0 +1,6 @@
+resource "apono_access_flow_v2" "example" {
+  name                  = "Production database access"
+  trigger               = "SELF_SERVE"
+  grant_duration_in_min = provider::apono::duration_minutes("8h")
+  # ...
+}
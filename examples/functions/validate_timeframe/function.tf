@@ -0,0 +1,6 @@
+resource "apono_access_flow_v2" "example" {
+  name      = "Business hours access"
+  trigger   = "SELF_SERVE"
+  timeframe = provider::apono::validate_timeframe("08:00", "17:00", ["MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY"], "Asia/Jerusalem")
+  # ...
+}
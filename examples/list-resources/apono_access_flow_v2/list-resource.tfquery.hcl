@@ -0,0 +1,7 @@
+list "apono_access_flow_v2" "production" {
+  provider = apono
+
+  config {
+    name = "production"
+  }
+}
@@ -0,0 +1,7 @@
+list "apono_access_scope" "production" {
+  provider = apono
+
+  config {
+    name = "*prod*"
+  }
+}
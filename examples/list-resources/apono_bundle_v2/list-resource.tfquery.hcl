@@ -0,0 +1,7 @@
+list "apono_bundle_v2" "production" {
+  provider = apono
+
+  config {
+    name = "prod*"
+  }
+}
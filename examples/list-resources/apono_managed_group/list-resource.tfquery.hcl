@@ -0,0 +1,7 @@
+list "apono_managed_group" "engineering" {
+  provider = apono
+
+  config {
+    name = "eng*"
+  }
+}
@@ -0,0 +1,7 @@
+list "apono_resource_integration" "postgres" {
+  provider = apono
+
+  config {
+    type = "postgresql"
+  }
+}
@@ -0,0 +1,73 @@
+resource "apono_access_flow_v2" "approver_check" {
+  name                  = "Production Database Access with Approver Check"
+  active                = true
+  grant_duration_in_min = 60
+  trigger               = "SELF_SERVE"
+  approver_check        = "error"
+
+  requestors = {
+    logical_operator = "OR"
+    conditions = [
+      {
+        type   = "group"
+        values = ["Engineering"]
+      }
+    ]
+  }
+
+  access_targets = [
+    {
+      integration = {
+        integration_name = "Production PostgreSQL"
+        resource_type    = "postgresql-database"
+        permissions      = ["READ_ONLY"]
+      }
+    }
+  ]
+
+  approver_policy = {
+    approval_mode = "ALL_OF"
+    approver_groups = [
+      {
+        logical_operator = "OR"
+        approvers = [
+          {
+            type   = "group"
+            values = ["DBA"]
+          }
+        ]
+      },
+      {
+        logical_operator = "OR"
+        approvers = [
+          {
+            type = "manager"
+          }
+        ]
+      }
+    ]
+  }
+
+  escalation_policy = {
+    interval_in_min = 30
+    approver_groups = [
+      {
+        logical_operator = "OR"
+        approvers = [
+          {
+            type   = "user"
+            values = ["security@company.io"]
+          }
+        ]
+      }
+    ]
+  }
+
+  settings = {
+    requester_cannot_approve_self = true
+  }
+}
+
+output "production_database_approvers" {
+  value = apono_access_flow_v2.approver_check.effective_approvers
+}
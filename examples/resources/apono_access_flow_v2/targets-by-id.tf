@@ -0,0 +1,56 @@
+resource "apono_access_flow_v2" "targets_by_id_flow" {
+  name                  = "Access to production databases"
+  active                = true
+  grant_duration_in_min = 60
+  trigger               = "SELF_SERVE"
+
+  requestors = {
+    logical_operator = "OR"
+    conditions = [
+      {
+        type   = "group"
+        values = ["RND-team"]
+      }
+    ]
+  }
+
+  # Referencing targets by ID keeps the access flow valid when they are renamed.
+  access_targets = [
+    {
+      integration = {
+        integration_id = apono_resource_integration.postgresql_prod.id
+        resource_type  = "postgresql-database"
+        permissions    = ["READ_ONLY"]
+      }
+    },
+    {
+      bundle = {
+        bundle_id = apono_bundle_v2.critical_prod_db.id
+      }
+    },
+    {
+      access_scope = {
+        access_scope_id = apono_access_scope.production_db.id
+      }
+    }
+  ]
+
+  approver_policy = {
+    approval_mode = "ANY_OF"
+    approver_groups = [
+      {
+        logical_operator = "OR"
+        approvers = [
+          {
+            type   = "user"
+            values = ["dba@company.io"]
+          }
+        ]
+      }
+    ]
+  }
+
+  settings = {
+    justification_required = true
+  }
+}
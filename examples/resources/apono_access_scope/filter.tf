@@ -0,0 +1,21 @@
+resource "apono_access_scope" "production_data_stores" {
+  name = "production-data-stores"
+  filter = {
+    tags = [
+      {
+        key    = "env"
+        values = ["production"]
+      }
+    ]
+    groups = [
+      {
+        operator               = "or"
+        resource_types         = ["aws-rds-mysql-database", "aws-rds-postgresql-database"]
+        resource_name_contains = ["orders"]
+      }
+    ]
+  }
+}
+
+# The compiled query is available as apono_access_scope.production_data_stores.query:
+# resource_tag["env"] = "production" and (resource_type in ("aws-rds-mysql-database", "aws-rds-postgresql-database") or resource_name contains "orders")
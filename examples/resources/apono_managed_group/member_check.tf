@@ -0,0 +1,8 @@
+resource "apono_managed_group" "platform_team" {
+  name         = "Platform Team"
+  member_check = "error"
+  members = [
+    "alice@example.com",
+    "bob@example.com"
+  ]
+}
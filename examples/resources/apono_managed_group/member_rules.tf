@@ -0,0 +1,15 @@
+resource "apono_managed_group" "platform_engineers" {
+  name = "Platform Engineers"
+  member_rules = [
+    {
+      type           = "attribute"
+      attribute_name = "department"
+      values         = ["Platform"]
+    },
+    {
+      type           = "role"
+      match_operator = "is_not"
+      values         = ["Admin"]
+    }
+  ]
+}
@@ -0,0 +1,13 @@
+resource "apono_resource_integration" "aws_account_integration_by_connector_name" {
+  name            = "AWS Production Account"
+  type            = "aws-account"
+  connector_name  = "prod-eks"
+  connector_check = "error"
+  connected_resource_types = [
+    "aws-account-iam-group",
+    "aws-account-s3-bucket"
+  ]
+  integration_config = {
+    region = "us-east-1"
+  }
+}
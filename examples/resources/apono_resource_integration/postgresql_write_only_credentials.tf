@@ -0,0 +1,26 @@
+variable "postgresql_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "apono_resource_integration" "postgresql_staging_dbs" {
+  name         = "PostgreSQL Staging Databases"
+  type         = "postgresql"
+  connector_id = "AwsConnector-StagingTeam-XYZ123"
+  connected_resource_types = [
+    "postgresql-database",
+    "postgresql-table"
+  ]
+  integration_config = {
+    hostname = "staging-postgresql.us-east-1.internal.example.com"
+    port     = "5432"
+    dbname   = "postgres"
+    sslmode  = "require"
+  }
+  # Sent to Apono but never stored in the Terraform state. Increment the version to send a new password.
+  integration_config_wo = {
+    username = "apono"
+    password = var.postgresql_password
+  }
+  integration_config_wo_version = 1
+}
@@ -0,0 +1,30 @@
+resource "apono_resource_integration" "ssh_integration" {
+  name         = "SSH Servers"
+  type         = "ssh"
+  connector_id = "apono-connector-id"
+  connected_resource_types = [
+    "ssh-server"
+  ]
+  integration_config = {
+    servers = [
+      {
+        name = "debian-web"
+        host = "10.0.5.42"
+        user = "deploy"
+        port = "2222"
+      },
+      {
+        name = "centos-db"
+        host = "192.168.1.50"
+        user = "deploy"
+        port = "22"
+      }
+    ]
+  }
+  secret_store_config = {
+    aws = {
+      region    = "us-east-1"
+      secret_id = "ssh/deploy-key"
+    }
+  }
+}
@@ -22,6 +22,13 @@ type IntegrationModel struct {
 	IntegrationOwners      []IntegrationOwner     `tfsdk:"integration_owners"`
 }
 
+// IntegrationResourceModel extends IntegrationModel with the write-only attributes, which only exist in the resource.
+type IntegrationResourceModel struct {
+	IntegrationModel
+	MetadataWO        types.Map   `tfsdk:"metadata_wo"`
+	MetadataWOVersion types.Int64 `tfsdk:"metadata_wo_version"`
+}
+
 type AwsSecret struct {
 	Region   types.String `tfsdk:"region"`
 	SecretID types.String `tfsdk:"secret_id"`
@@ -21,9 +21,11 @@ type ManualWebhookTypeModel struct {
 }
 
 type ManualWebhookHttpRequestTypeModel struct {
-	Url     types.String `tfsdk:"url"`
-	Method  types.String `tfsdk:"method"`
-	Headers types.Map    `tfsdk:"headers"`
+	Url              types.String `tfsdk:"url"`
+	Method           types.String `tfsdk:"method"`
+	Headers          types.Map    `tfsdk:"headers"`
+	HeadersWO        types.Map    `tfsdk:"headers_wo"`
+	HeadersWOVersion types.Int64  `tfsdk:"headers_wo_version"`
 }
 
 type ManualWebhookIntegrationTypeModel struct {
@@ -41,8 +43,10 @@ type ManualWebhookAuthenticationConfigModel struct {
 }
 
 type WebhookOAuthConfigModel struct {
-	ClientId         types.String   `tfsdk:"client_id"`
-	ClientSecret     types.String   `tfsdk:"client_secret"`
-	TokenEndpointUrl types.String   `tfsdk:"token_endpoint_url"`
-	Scopes           []types.String `tfsdk:"scopes"`
+	ClientId              types.String   `tfsdk:"client_id"`
+	ClientSecret          types.String   `tfsdk:"client_secret"`
+	ClientSecretWO        types.String   `tfsdk:"client_secret_wo"`
+	ClientSecretWOVersion types.Int64    `tfsdk:"client_secret_wo_version"`
+	TokenEndpointUrl      types.String   `tfsdk:"token_endpoint_url"`
+	Scopes                []types.String `tfsdk:"scopes"`
 }
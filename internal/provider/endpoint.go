@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// parseEndpoint validates the configured API endpoint and returns it as a base URL.
+// Path prefixes (e.g. https://gateway.example.com/apono) are preserved, trailing slashes are removed.
+func parseEndpoint(endpoint string) (*url.URL, error) {
+	endpointUrl, err := url.Parse(strings.TrimSpace(endpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	if endpointUrl.Scheme != "http" && endpointUrl.Scheme != "https" {
+		return nil, fmt.Errorf("scheme must be http or https, got %q", endpointUrl.Scheme)
+	}
+
+	if endpointUrl.Host == "" {
+		return nil, fmt.Errorf("host is missing")
+	}
+
+	if endpointUrl.User != nil {
+		return nil, fmt.Errorf("user info is not supported, use personal_token for authentication")
+	}
+
+	if endpointUrl.RawQuery != "" || endpointUrl.Fragment != "" {
+		return nil, fmt.Errorf("query parameters and fragments are not supported")
+	}
+
+	endpointUrl.Path = strings.TrimRight(endpointUrl.Path, "/")
+	endpointUrl.RawPath = strings.TrimRight(endpointUrl.RawPath, "/")
+
+	return endpointUrl, nil
+}
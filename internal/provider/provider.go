@@ -4,26 +4,37 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"net/url"
 	"os"
+	"strconv"
 
 	"github.com/apono-io/apono-sdk-go"
 	"github.com/apono-io/terraform-provider-apono/internal/aponoapi"
 	v2client "github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
 	v2datasources "github.com/apono-io/terraform-provider-apono/internal/v2/datasources"
+	v2functions "github.com/apono-io/terraform-provider-apono/internal/v2/functions"
+	v2listresources "github.com/apono-io/terraform-provider-apono/internal/v2/listresources"
 	v2resources "github.com/apono-io/terraform-provider-apono/internal/v2/resources"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure AponoProvider satisfies various provider interfaces.
 var _ provider.Provider = &AponoProvider{}
+var _ provider.ProviderWithListResources = &AponoProvider{}
+var _ provider.ProviderWithFunctions = &AponoProvider{}
 var _ v2client.ClientProvider = &AponoProvider{}
+var _ common.SettingsProvider = &AponoProvider{}
 
 // AponoProvider defines the provider implementation.
 type AponoProvider struct {
@@ -34,12 +45,23 @@ type AponoProvider struct {
 	client          *apono.APIClient
 	terraformClient *aponoapi.APIClient
 	publicClient    *v2client.Client
+	listCache       *v2client.CachingInvoker
+	settings        common.ProviderSettings
 }
 
 // AponoProviderConfig describes the provider data model.
 type AponoProviderConfig struct {
-	Endpoint      types.String `tfsdk:"endpoint"`
-	PersonalToken types.String `tfsdk:"personal_token"`
+	Endpoint            types.String `tfsdk:"endpoint"`
+	PersonalToken       types.String `tfsdk:"personal_token"`
+	CACertFile          types.String `tfsdk:"ca_cert_file"`
+	CACertPEM           types.String `tfsdk:"ca_cert_pem"`
+	ClientCert          types.String `tfsdk:"client_cert"`
+	ClientKey           types.String `tfsdk:"client_key"`
+	ProxyURL            types.String `tfsdk:"proxy_url"`
+	InsecureSkipVerify  types.Bool   `tfsdk:"insecure_skip_verify"`
+	ValidateCredentials types.Bool   `tfsdk:"validate_credentials"`
+	DefaultLabels       types.Set    `tfsdk:"default_labels"`
+	ReadOnly            types.Bool   `tfsdk:"read_only"`
 }
 
 func (p *AponoProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -56,7 +78,7 @@ func (p *AponoProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 			"- See [Apono API Authentication](https://docs.apono.io/api-reference#authentication) for details on obtaining a personal token.",
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				Description: "Override API endpoint. This can also be set via the APONO_ENDPOINT environment variable, and is usually used for testing purposes.",
+				Description: "Override API endpoint. This can also be set via the APONO_ENDPOINT environment variable, and is usually used for testing purposes. Must be an absolute http(s) URL; a path prefix (e.g., https://gateway.example.com/apono) is preserved for all API calls.",
 				Optional:    true,
 			},
 			"personal_token": schema.StringAttribute{
@@ -64,6 +86,56 @@ func (p *AponoProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM-encoded CA certificate bundle used to verify the Apono API endpoint, in addition to the system trust store. Useful when requests go through a TLS-intercepting proxy with a private CA.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded CA certificate bundle used to verify the Apono API endpoint, in addition to the system trust store. Conflicts with ca_cert_file.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"client_cert": schema.StringAttribute{
+				Description: "PEM-encoded client certificate presented to the Apono API endpoint for mutual TLS. Must be set together with client_key.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				Description: "PEM-encoded private key of the client certificate used for mutual TLS. Must be set together with client_cert.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert")),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of an HTTP(S) proxy used for all requests to the Apono API (e.g., http://proxy.example.com:3128). When not set, the standard HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables are respected.",
+				Optional:    true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip TLS certificate verification of the Apono API endpoint. Intended for testing only; never enable it in production.",
+				Optional:    true,
+			},
+			"validate_credentials": schema.BoolAttribute{
+				Description: "Verify connectivity to the Apono API and the validity of the personal token while configuring the provider, failing fast with a clear error instead of on the first resource operation. Defaults to false.",
+				Optional:    true,
+			},
+			"default_labels": schema.SetAttribute{
+				Description: "Labels added to every access flow managed by this provider, in addition to the labels set on the access flow itself. The merged set is exposed in the settings.labels_all attribute of apono_access_flow_v2.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"read_only": schema.BoolAttribute{
+				Description: "Prevent the provider from making any changes to the Apono tenant. Creating, updating or deleting resources fails with an error, and any non-GET API request is blocked. Useful for drift detection and plan-only pipelines. This can also be set via the APONO_READ_ONLY environment variable. Defaults to false.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -75,6 +147,19 @@ func (p *AponoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	endpoint := os.Getenv("APONO_ENDPOINT")
 	personalToken := os.Getenv("APONO_PERSONAL_TOKEN")
 
+	readOnly := false
+	if value := os.Getenv("APONO_READ_ONLY"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Read-Only Configuration",
+				fmt.Sprintf("Failed to parse the APONO_READ_ONLY environment variable value %q as a boolean: %s", value, err.Error()),
+			)
+			return
+		}
+		readOnly = parsed
+	}
+
 	var config AponoProviderConfig
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
@@ -89,13 +174,18 @@ func (p *AponoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		personalToken = config.PersonalToken.ValueString()
 	}
 
+	if !config.ReadOnly.IsNull() {
+		readOnly = config.ReadOnly.ValueBool()
+	}
+
 	if endpoint == "" {
 		endpoint = "https://api.apono.io"
 	}
 
-	endpointUrl, err := url.Parse(endpoint)
+	endpointUrl, err := parseEndpoint(endpoint)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
 			"Invalid Endpoint Configuration",
 			fmt.Sprintf("Failed to parse endpoint %s: %s", endpoint, err.Error()),
 		)
@@ -114,36 +204,92 @@ func (p *AponoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
+	if config.InsecureSkipVerify.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Insecure TLS Configuration",
+			"TLS certificate verification of the Apono API endpoint is disabled via insecure_skip_verify. "+
+				"This setting is intended for testing only and must not be used in production.",
+		)
+	}
+
+	baseTransport, err := newHTTPTransport(transportConfig{
+		CACertFile:         config.CACertFile.ValueString(),
+		CACertPEM:          config.CACertPEM.ValueString(),
+		ClientCert:         config.ClientCert.ValueString(),
+		ClientKey:          config.ClientKey.ValueString(),
+		ProxyURL:           config.ProxyURL.ValueString(),
+		InsecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid TLS or Proxy Configuration",
+			fmt.Sprintf("Failed to configure the HTTP transport for the Apono API: %s", err.Error()),
+		)
+		return
+	}
+
+	if readOnly {
+		baseTransport = &v2client.ReadOnlyTransport{Transport: baseTransport}
+	}
+
+	baseURL := endpointUrl.String()
+
+	v2Client, err := p.initializeV2Client(baseURL, personalToken, baseTransport)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Apono V2 API Client",
+			"An unexpected error occurred when creating the Apono V2 API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"Apono V2 Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	p.publicClient = v2Client
+	p.listCache = v2client.NewCachingInvoker(v2Client)
+
+	// Writes made by the v1 clients invalidate the list cache as well.
+	httpClient := &http.Client{
+		Transport: &v2client.InvalidatingTransport{Cache: p.listCache, Transport: baseTransport},
+	}
+
 	// Configure v1 SDK client
 	cfg := apono.NewConfiguration()
-	cfg.Scheme = endpointUrl.Scheme
-	cfg.Host = endpointUrl.Host
+	cfg.Servers = apono.ServerConfigurations{{URL: baseURL}}
 	cfg.UserAgent = fmt.Sprintf("terraform-provider-apono/%s", p.version)
 	cfg.AddDefaultHeader("Authorization", fmt.Sprintf("Bearer %s", personalToken))
+	cfg.HTTPClient = httpClient
 
 	p.client = apono.NewAPIClient(cfg)
 
 	// Configure terraform API client
 	terraformApiCfg := aponoapi.NewConfiguration()
-	terraformApiCfg.Scheme = cfg.Scheme
-	terraformApiCfg.Host = cfg.Host
+	terraformApiCfg.Servers = aponoapi.ServerConfigurations{{URL: baseURL}}
 	terraformApiCfg.UserAgent = cfg.UserAgent
 	terraformApiCfg.AddDefaultHeader("Authorization", fmt.Sprintf("Bearer %s", personalToken))
+	terraformApiCfg.HTTPClient = httpClient
 
 	p.terraformClient = aponoapi.NewAPIClient(terraformApiCfg)
 
-	v2Client, err := p.initializeV2Client(endpointUrl, personalToken)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Create Apono V2 API Client",
-			"An unexpected error occurred when creating the Apono V2 API client. "+
-				"If the error is not clear, please contact the provider developers.\n\n"+
-				"Apono V2 Client Error: "+err.Error(),
-		)
-		return
+	var defaultLabels []string
+	if !config.DefaultLabels.IsNull() && !config.DefaultLabels.IsUnknown() {
+		resp.Diagnostics.Append(config.DefaultLabels.ElementsAs(ctx, &defaultLabels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	p.publicClient = v2Client
+	p.settings = common.ProviderSettings{
+		DefaultLabels: defaultLabels,
+		ReadOnly:      readOnly,
+	}
+
+	if config.ValidateCredentials.ValueBool() {
+		resp.Diagnostics.Append(p.validateCredentials(ctx, baseURL)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	tflog.Debug(ctx, "Provider configuration complete", map[string]any{
 		"endpoint": endpoint,
@@ -153,13 +299,11 @@ func (p *AponoProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	resp.ResourceData = p
 }
 
-func (p *AponoProvider) initializeV2Client(endpointUrl *url.URL, token string) (*v2client.Client, error) {
-	baseURL := fmt.Sprintf("%s://%s", endpointUrl.Scheme, endpointUrl.Host)
-
+func (p *AponoProvider) initializeV2Client(baseURL string, token string, baseTransport http.RoundTripper) (*v2client.Client, error) {
 	transport := &v2client.DebugTransport{
 		Transport: &v2client.UserAgentTransport{
 			UserAgent: fmt.Sprintf("terraform-provider-apono/%s", p.version),
-			Transport: http.DefaultTransport,
+			Transport: baseTransport,
 		},
 	}
 
@@ -176,6 +320,33 @@ func (p *AponoProvider) initializeV2Client(endpointUrl *url.URL, token string) (
 	)
 }
 
+// validateCredentials performs a minimal authenticated API call to verify connectivity and the personal token.
+func (p *AponoProvider) validateCredentials(ctx context.Context, baseURL string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	_, err := p.publicClient.ListGroupsV1(ctx, v2client.ListGroupsV1Params{Limit: v2client.NewOptInt32(1)})
+	if err == nil {
+		return diags
+	}
+
+	if v2client.IsUnauthorizedError(err) {
+		diags.AddError(
+			"Invalid Apono Credentials",
+			fmt.Sprintf("The Apono API at %s rejected the configured personal token. "+
+				"Verify the token in the personal_token attribute or the APONO_PERSONAL_TOKEN environment variable "+
+				"is valid, not expired, and has the required permissions.\n\nError: %s", baseURL, err.Error()),
+		)
+		return diags
+	}
+
+	diags.AddError(
+		"Unable to Connect to the Apono API",
+		fmt.Sprintf("Failed to reach the Apono API at %s. Verify the endpoint, proxy and TLS settings of the provider.\n\nError: %s", baseURL, err.Error()),
+	)
+
+	return diags
+}
+
 func (p *AponoProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewIntegrationResource,
@@ -202,6 +373,25 @@ func (p *AponoProvider) DataSources(_ context.Context) []func() datasource.DataS
 	}
 }
 
+func (p *AponoProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		v2listresources.NewAponoAccessScopeListResource,
+		v2listresources.NewAponoManagedGroupListResource,
+		v2listresources.NewAponoResourceIntegrationListResource,
+		v2listresources.NewAponoAccessFlowV2ListResource,
+		v2listresources.NewAponoBundleV2ListResource,
+	}
+}
+
+func (p *AponoProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		v2functions.NewDurationMinutesFunction,
+		v2functions.NewAQLFunction,
+		v2functions.NewConditionFunction,
+		v2functions.NewValidateTimeframeFunction,
+	}
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &AponoProvider{
@@ -235,6 +425,12 @@ func toProvider(in any) (*AponoProvider, diag.Diagnostics) {
 }
 
 // PublicClient implements the ClientProvider interface.
-func (p *AponoProvider) PublicClient() *v2client.Client {
-	return p.publicClient
+// List operations are served from the provider-scoped list cache.
+func (p *AponoProvider) PublicClient() v2client.Invoker {
+	return p.listCache
+}
+
+// Settings implements the SettingsProvider interface.
+func (p *AponoProvider) Settings() common.ProviderSettings {
+	return p.settings
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/apono-io/terraform-provider-apono/internal/schemas"
 	"github.com/apono-io/terraform-provider-apono/internal/services"
 	"github.com/apono-io/terraform-provider-apono/internal/utils"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -16,6 +17,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &accessBundleResource{}
 var _ resource.ResourceWithImportState = &accessBundleResource{}
+var _ resource.ResourceWithIdentity = &accessBundleResource{}
 
 func NewAccessBundleResource() resource.Resource {
 	return &accessBundleResource{}
@@ -28,6 +30,7 @@ type accessBundleResource struct {
 
 func (a accessBundleResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
 	response.TypeName = request.ProviderTypeName + "_access_bundle"
+	response.ResourceBehavior.MutableIdentity = true
 }
 
 func (a accessBundleResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
@@ -50,6 +53,10 @@ func (a accessBundleResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (a accessBundleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = common.GetResourceIdentitySchema("access bundle")
+}
+
 func (a *accessBundleResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	a.provider, response.Diagnostics = toProvider(request.ProviderData)
 }
@@ -93,6 +100,7 @@ func (a accessBundleResource) Read(ctx context.Context, request resource.ReadReq
 
 	// Save updated data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+	response.Diagnostics.Append(common.SetResourceIdentity(ctx, response.Identity, model.ID, model.Name)...)
 
 	tflog.Debug(ctx, "Successfully fetching access bundle", map[string]interface{}{
 		"id": data.ID.ValueString(),
@@ -100,6 +108,11 @@ func (a accessBundleResource) Read(ctx context.Context, request resource.ReadReq
 }
 
 func (a accessBundleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	response.Diagnostics.Append(a.provider.settings.CheckWriteAllowed("create access bundle")...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	var data *models.AccessBundleModel
 
 	// Read Terraform plan data into the model
@@ -131,6 +144,7 @@ func (a accessBundleResource) Create(ctx context.Context, request resource.Creat
 
 	// Save data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+	response.Diagnostics.Append(common.SetResourceIdentity(ctx, response.Identity, model.ID, model.Name)...)
 
 	tflog.Debug(ctx, "Successfully created access bundle", map[string]interface{}{
 		"id": data.ID.ValueString(),
@@ -138,6 +152,11 @@ func (a accessBundleResource) Create(ctx context.Context, request resource.Creat
 }
 
 func (a accessBundleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	response.Diagnostics.Append(a.provider.settings.CheckWriteAllowed("update access bundle")...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	var data *models.AccessBundleModel
 
 	// Read Terraform plan data into the model
@@ -175,6 +194,7 @@ func (a accessBundleResource) Update(ctx context.Context, request resource.Updat
 
 	// Save updated data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+	response.Diagnostics.Append(common.SetResourceIdentity(ctx, response.Identity, model.ID, model.Name)...)
 
 	tflog.Debug(ctx, "Successfully updated access bundle", map[string]interface{}{
 		"id": data.ID.ValueString(),
@@ -182,6 +202,11 @@ func (a accessBundleResource) Update(ctx context.Context, request resource.Updat
 }
 
 func (a accessBundleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	response.Diagnostics.Append(a.provider.settings.CheckWriteAllowed("delete access bundle")...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	var data *models.AccessBundleModel
 
 	// Read Terraform prior state data into the model
@@ -218,7 +243,12 @@ func (a accessBundleResource) Delete(ctx context.Context, request resource.Delet
 }
 
 func (a accessBundleResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	accessBundleId := request.ID
+	accessBundleId, diags := common.GetImportID(ctx, request)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Importing access bundle", map[string]interface{}{
 		"id": accessBundleId,
 	})
@@ -239,6 +269,7 @@ func (a accessBundleResource) ImportState(ctx context.Context, request resource.
 
 	// Save imported data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+	response.Diagnostics.Append(common.SetResourceIdentity(ctx, response.Identity, model.ID, model.Name)...)
 
 	tflog.Debug(ctx, "Successfully imported access bundle", map[string]interface{}{
 		"id": accessBundleId,
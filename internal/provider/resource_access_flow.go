@@ -8,6 +8,7 @@ import (
 	"github.com/apono-io/terraform-provider-apono/internal/schemas"
 	"github.com/apono-io/terraform-provider-apono/internal/services"
 	"github.com/apono-io/terraform-provider-apono/internal/utils"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -27,6 +28,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &accessFlowResource{}
 var _ resource.ResourceWithImportState = &accessFlowResource{}
+var _ resource.ResourceWithIdentity = &accessFlowResource{}
 
 var _ resource.ResourceWithValidateConfig = &accessFlowResource{}
 
@@ -41,6 +43,7 @@ type accessFlowResource struct {
 
 func (a accessFlowResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
 	response.TypeName = request.ProviderTypeName + "_access_flow"
+	response.ResourceBehavior.MutableIdentity = true
 }
 
 func (a accessFlowResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
@@ -251,6 +254,10 @@ func (a accessFlowResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+func (a accessFlowResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = common.GetResourceIdentitySchema("access flow")
+}
+
 func (a *accessFlowResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	a.provider, response.Diagnostics = toProvider(request.ProviderData)
 }
@@ -295,6 +302,7 @@ func (a accessFlowResource) Read(ctx context.Context, request resource.ReadReque
 
 	// Save updated data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+	response.Diagnostics.Append(common.SetResourceIdentity(ctx, response.Identity, model.ID, model.Name)...)
 
 	tflog.Debug(ctx, "Successfully fetching access flow", map[string]interface{}{
 		"id": data.ID.ValueString(),
@@ -302,6 +310,11 @@ func (a accessFlowResource) Read(ctx context.Context, request resource.ReadReque
 }
 
 func (a accessFlowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	response.Diagnostics.Append(a.provider.settings.CheckWriteAllowed("create access flow")...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	var data *models.AccessFlowModel
 
 	// Read Terraform plan data into the model
@@ -333,6 +346,7 @@ func (a accessFlowResource) Create(ctx context.Context, request resource.CreateR
 
 	// Save data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+	response.Diagnostics.Append(common.SetResourceIdentity(ctx, response.Identity, model.ID, model.Name)...)
 
 	tflog.Debug(ctx, "Successfully created access flow", map[string]interface{}{
 		"id": data.ID.ValueString(),
@@ -340,6 +354,11 @@ func (a accessFlowResource) Create(ctx context.Context, request resource.CreateR
 }
 
 func (a accessFlowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	response.Diagnostics.Append(a.provider.settings.CheckWriteAllowed("update access flow")...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	var data *models.AccessFlowModel
 
 	// Read Terraform plan data into the model
@@ -377,6 +396,7 @@ func (a accessFlowResource) Update(ctx context.Context, request resource.UpdateR
 
 	// Save updated data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+	response.Diagnostics.Append(common.SetResourceIdentity(ctx, response.Identity, model.ID, model.Name)...)
 
 	tflog.Debug(ctx, "Successfully updated access flow", map[string]interface{}{
 		"id": data.ID.ValueString(),
@@ -384,6 +404,11 @@ func (a accessFlowResource) Update(ctx context.Context, request resource.UpdateR
 }
 
 func (a accessFlowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	response.Diagnostics.Append(a.provider.settings.CheckWriteAllowed("delete access flow")...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	var data *models.AccessFlowModel
 
 	// Read Terraform prior state data into the model
@@ -420,7 +445,12 @@ func (a accessFlowResource) Delete(ctx context.Context, request resource.DeleteR
 }
 
 func (a accessFlowResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	accessFlowId := request.ID
+	accessFlowId, diags := common.GetImportID(ctx, request)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Importing access flow", map[string]interface{}{
 		"id": accessFlowId,
 	})
@@ -441,6 +471,7 @@ func (a accessFlowResource) ImportState(ctx context.Context, request resource.Im
 
 	// Save imported data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+	response.Diagnostics.Append(common.SetResourceIdentity(ctx, response.Identity, model.ID, model.Name)...)
 
 	tflog.Debug(ctx, "Successfully imported access flow", map[string]interface{}{
 		"id": accessFlowId,
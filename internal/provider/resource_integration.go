@@ -7,13 +7,17 @@ import (
 	"github.com/apono-io/terraform-provider-apono/internal/aponoapi"
 	"github.com/apono-io/terraform-provider-apono/internal/schemas"
 	"github.com/apono-io/terraform-provider-apono/internal/services"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/apono-io/terraform-provider-apono/internal/models"
 	"github.com/apono-io/terraform-provider-apono/internal/utils"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -27,6 +31,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &integrationResource{}
 var _ resource.ResourceWithImportState = &integrationResource{}
+var _ resource.ResourceWithIdentity = &integrationResource{}
 var _ resource.ResourceWithValidateConfig = &integrationResource{}
 
 var (
@@ -50,6 +55,7 @@ type integrationResource struct {
 
 func (r *integrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_integration"
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -93,6 +99,23 @@ func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Optional:            true,
 				ElementType:         types.StringType,
 			},
+			"metadata_wo": schema.MapAttribute{
+				MarkdownDescription: "Write-only integration metadata, merged with `metadata` when sent to Apono and never stored in the Terraform state. Use it for secrets of integrations without a secret store. Requires Terraform 1.11 or later.",
+				Optional:            true,
+				WriteOnly:           true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.AlsoRequires(path.MatchRoot("metadata_wo_version")),
+				},
+			},
+			"metadata_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `metadata_wo`. Write-only values are not stored, so changes to them are not detected; change this value to send the updated `metadata_wo` to Apono.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("metadata_wo")),
+				},
+			},
 			"aws_secret": schema.SingleNestedAttribute{
 				Optional: true,
 				Attributes: map[string]schema.Attribute{
@@ -179,23 +202,39 @@ func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *integrationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.GetResourceIdentitySchema("integration")
+}
+
 func (r *integrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	r.provider, resp.Diagnostics = toProvider(req.ProviderData)
 }
 
 func (r *integrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data *models.IntegrationModel
+	resp.Diagnostics.Append(r.provider.settings.CheckWriteAllowed("create integration")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Read Terraform plan data into the model
+	var data *models.IntegrationResourceModel
+
+	// Read Terraform plan data into the model, and the write-only metadata from the config
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("metadata_wo"), &data.MetadataWO)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	mergedMetadata, err := services.MergeWriteOnlyMap(data.Metadata, data.MetadataWO)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("metadata_wo"), "Invalid metadata_wo", err.Error())
+		return
+	}
+
 	metadata := map[string]interface{}{}
-	for name, value := range data.Metadata.Elements() {
-		metadata[name] = utils.AttrValueToString(value)
+	for name, value := range mergedMetadata {
+		metadata[name] = value
 	}
 
 	var connectedResourceTypes []string
@@ -258,11 +297,12 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	model, diagnostics := services.ConvertToIntegrationModel(ctx, integration)
+	integrationModel, diagnostics := services.ConvertToIntegrationModel(ctx, integration)
 	if len(diagnostics) > 0 {
 		resp.Diagnostics.Append(diagnostics...)
 		return
 	}
+	model := services.ConvertToIntegrationResourceModel(integrationModel, data)
 
 	tflog.Debug(ctx, "Created integration", map[string]interface{}{
 		"id": data.ID.ValueString(),
@@ -270,10 +310,11 @@ func (r *integrationResource) Create(ctx context.Context, req resource.CreateReq
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, model.ID, model.Name)...)
 }
 
 func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data *models.IntegrationModel
+	var data *models.IntegrationResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -298,29 +339,43 @@ func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest
 
 		return
 	}
-	model, diagnostics := services.ConvertToIntegrationModel(ctx, integration)
+	integrationModel, diagnostics := services.ConvertToIntegrationModel(ctx, integration)
 	if len(diagnostics) > 0 {
 		resp.Diagnostics.Append(diagnostics...)
 		return
 	}
+	model := services.ConvertToIntegrationResourceModel(integrationModel, data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, model.ID, model.Name)...)
 }
 
 func (r *integrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data *models.IntegrationModel
+	resp.Diagnostics.Append(r.provider.settings.CheckWriteAllowed("update integration")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data *models.IntegrationResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan data into the model, and the write-only metadata from the config
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("metadata_wo"), &data.MetadataWO)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	mergedMetadata, err := services.MergeWriteOnlyMap(data.Metadata, data.MetadataWO)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("metadata_wo"), "Invalid metadata_wo", err.Error())
+		return
+	}
+
 	metadata := map[string]interface{}{}
-	for name, value := range data.Metadata.Elements() {
-		metadata[name] = utils.AttrValueToString(value)
+	for name, value := range mergedMetadata {
+		metadata[name] = value
 	}
 
 	var connectedResourceTypes []string
@@ -383,11 +438,12 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	model, diagnostics := services.ConvertToIntegrationModel(ctx, integration)
+	integrationModel, diagnostics := services.ConvertToIntegrationModel(ctx, integration)
 	if len(diagnostics) > 0 {
 		resp.Diagnostics.Append(diagnostics...)
 		return
 	}
+	model := services.ConvertToIntegrationResourceModel(integrationModel, data)
 
 	tflog.Debug(ctx, "Updated integration", map[string]interface{}{
 		"id": data.ID.ValueString(),
@@ -395,10 +451,16 @@ func (r *integrationResource) Update(ctx context.Context, req resource.UpdateReq
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, model.ID, model.Name)...)
 }
 
 func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data *models.IntegrationModel
+	resp.Diagnostics.Append(r.provider.settings.CheckWriteAllowed("delete integration")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data *models.IntegrationResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -430,7 +492,12 @@ func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *integrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	integrationId := req.ID
+	integrationId, diags := common.GetImportID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "importing integration", map[string]interface{}{
 		"id": integrationId,
 	})
@@ -443,14 +510,16 @@ func (r *integrationResource) ImportState(ctx context.Context, req resource.Impo
 		return
 	}
 
-	model, diagnostics := services.ConvertToIntegrationModel(ctx, integration)
+	integrationModel, diagnostics := services.ConvertToIntegrationModel(ctx, integration)
 	if len(diagnostics) > 0 {
 		resp.Diagnostics.Append(diagnostics...)
 		return
 	}
+	model := services.ConvertToIntegrationResourceModel(integrationModel, nil)
 
 	// Save imported data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, model.ID, model.Name)...)
 
 	tflog.Debug(ctx, "Imported integration", map[string]interface{}{
 		"id": integrationId,
@@ -462,7 +531,7 @@ func (r *integrationResource) ValidateConfig(ctx context.Context, req resource.V
 		return
 	}
 
-	var model models.IntegrationModel
+	var model models.IntegrationResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -486,7 +555,14 @@ func (r *integrationResource) ValidateConfig(ctx context.Context, req resource.V
 		resourcevalidator.ExactlyOneOf(supportedSecretExpressions...).ValidateResource(ctx, req, resp)
 	}
 
-	metadataElements := model.Metadata.Elements()
+	// Required parameters can be set in either metadata or metadata_wo
+	metadataElements := map[string]attr.Value{}
+	for name, value := range model.MetadataWO.Elements() {
+		metadataElements[name] = value
+	}
+	for name, value := range model.Metadata.Elements() {
+		metadataElements[name] = value
+	}
 	for _, param := range config.GetParams() {
 		paramName := param.GetId()
 		paramPossibleValues := param.GetValues()
@@ -6,14 +6,19 @@ import (
 	"github.com/apono-io/terraform-provider-apono/internal/models"
 	"github.com/apono-io/terraform-provider-apono/internal/services"
 	"github.com/apono-io/terraform-provider-apono/internal/utils"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -21,6 +26,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ManualWebhookResource{}
 var _ resource.ResourceWithImportState = &ManualWebhookResource{}
+var _ resource.ResourceWithIdentity = &ManualWebhookResource{}
 
 func NewWebhookResource() resource.Resource {
 	return &ManualWebhookResource{}
@@ -33,6 +39,7 @@ type ManualWebhookResource struct {
 
 func (w ManualWebhookResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
 	response.TypeName = request.ProviderTypeName + "_manual_webhook"
+	response.ResourceBehavior.MutableIdentity = true
 }
 
 func (w ManualWebhookResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
@@ -79,10 +86,30 @@ func (w ManualWebhookResource) Schema(_ context.Context, _ resource.SchemaReques
 								},
 							},
 							"headers": schema.MapAttribute{
-								MarkdownDescription: "Key-value pairs representing HTTP headers to include in the request. These headers can be used to pass metadata or authentication tokens",
-								Required:            true,
+								MarkdownDescription: "Key-value pairs representing HTTP headers to include in the request. These headers can be used to pass metadata or authentication tokens. At least one of `headers` or `headers_wo` must be set",
+								Optional:            true,
+								ElementType:         types.StringType,
+								Sensitive:           true,
+								Validators: []validator.Map{
+									mapvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("headers_wo")),
+								},
+							},
+							"headers_wo": schema.MapAttribute{
+								MarkdownDescription: "Write-only HTTP headers, merged with `headers` when sent to Apono and never stored in the Terraform state. Use it for authentication tokens. Requires Terraform 1.11 or later",
+								Optional:            true,
+								WriteOnly:           true,
 								ElementType:         types.StringType,
 								Sensitive:           true,
+								Validators: []validator.Map{
+									mapvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("headers_wo_version")),
+								},
+							},
+							"headers_wo_version": schema.Int64Attribute{
+								MarkdownDescription: "Version of `headers_wo`. Write-only values are not stored, so changes to them are not detected; change this value to send the updated `headers_wo` to Apono",
+								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("headers_wo")),
+								},
 							},
 						},
 					},
@@ -143,9 +170,28 @@ func (w ManualWebhookResource) Schema(_ context.Context, _ resource.SchemaReques
 								Required:            true,
 							},
 							"client_secret": schema.StringAttribute{
-								MarkdownDescription: "The secret associated with the client identifier. Keep this value secure, as it is critical for establishing trusted communication",
-								Required:            true,
+								MarkdownDescription: "The secret associated with the client identifier. Keep this value secure, as it is critical for establishing trusted communication. Exactly one of `client_secret` or `client_secret_wo` must be set",
+								Optional:            true,
+								Sensitive:           true,
+								Validators: []validator.String{
+									stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("client_secret_wo")),
+								},
+							},
+							"client_secret_wo": schema.StringAttribute{
+								MarkdownDescription: "Write-only variant of `client_secret`, sent to Apono but never stored in the Terraform state. Requires Terraform 1.11 or later",
+								Optional:            true,
+								WriteOnly:           true,
 								Sensitive:           true,
+								Validators: []validator.String{
+									stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("client_secret_wo_version")),
+								},
+							},
+							"client_secret_wo_version": schema.Int64Attribute{
+								MarkdownDescription: "Version of `client_secret_wo`. Write-only values are not stored, so changes to them are not detected; change this value to send the updated `client_secret_wo` to Apono",
+								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("client_secret_wo")),
+								},
 							},
 							"token_endpoint_url": schema.StringAttribute{
 								MarkdownDescription: "The URL where the webhook can request OAuth tokens. This is part of the OAuth workflow to obtain access tokens for secure access",
@@ -168,6 +214,10 @@ func (w ManualWebhookResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (w ManualWebhookResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = common.GetResourceIdentitySchema("manual webhook")
+}
+
 func (w *ManualWebhookResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	w.provider, response.Diagnostics = toProvider(request.ProviderData)
 }
@@ -208,9 +258,11 @@ func (w ManualWebhookResource) Read(ctx context.Context, request resource.ReadRe
 		response.Diagnostics.Append(diagnostics...)
 		return
 	}
+	services.KeepManualWebhookWriteOnlySecretsOutOfState(model, data)
 
 	// Save updated data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+	response.Diagnostics.Append(common.SetResourceIdentity(ctx, response.Identity, model.ID, model.Name)...)
 
 	tflog.Debug(ctx, "Successfully fetched manual webhook", map[string]interface{}{
 		"id": data.ID.ValueString(),
@@ -218,10 +270,16 @@ func (w ManualWebhookResource) Read(ctx context.Context, request resource.ReadRe
 }
 
 func (w ManualWebhookResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	response.Diagnostics.Append(w.provider.settings.CheckWriteAllowed("create manual webhook")...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	var data *models.ManualWebhookModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan data into the model, and the write-only attributes from the config
 	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	response.Diagnostics.Append(getManualWebhookWriteOnlyAttributes(ctx, request.Config, data)...)
 
 	if response.Diagnostics.HasError() {
 		return
@@ -247,9 +305,11 @@ func (w ManualWebhookResource) Create(ctx context.Context, request resource.Crea
 		response.Diagnostics.Append(diagnostics...)
 		return
 	}
+	services.KeepManualWebhookWriteOnlySecretsOutOfState(model, data)
 
 	// Save data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+	response.Diagnostics.Append(common.SetResourceIdentity(ctx, response.Identity, model.ID, model.Name)...)
 
 	tflog.Debug(ctx, "Successfully created manual webhook", map[string]interface{}{
 		"id": data.ID.ValueString(),
@@ -257,10 +317,16 @@ func (w ManualWebhookResource) Create(ctx context.Context, request resource.Crea
 }
 
 func (w ManualWebhookResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	response.Diagnostics.Append(w.provider.settings.CheckWriteAllowed("update manual webhook")...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	var data *models.ManualWebhookModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan data into the model, and the write-only attributes from the config
 	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	response.Diagnostics.Append(getManualWebhookWriteOnlyAttributes(ctx, request.Config, data)...)
 
 	if response.Diagnostics.HasError() {
 		return
@@ -291,9 +357,11 @@ func (w ManualWebhookResource) Update(ctx context.Context, request resource.Upda
 		response.Diagnostics.Append(diagnostics...)
 		return
 	}
+	services.KeepManualWebhookWriteOnlySecretsOutOfState(model, data)
 
 	// Save updated data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+	response.Diagnostics.Append(common.SetResourceIdentity(ctx, response.Identity, model.ID, model.Name)...)
 
 	tflog.Debug(ctx, "Successfully updated manual webhook", map[string]interface{}{
 		"id": data.ID.ValueString(),
@@ -301,6 +369,11 @@ func (w ManualWebhookResource) Update(ctx context.Context, request resource.Upda
 }
 
 func (w ManualWebhookResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	response.Diagnostics.Append(w.provider.settings.CheckWriteAllowed("delete manual webhook")...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	var data *models.ManualWebhookModel
 
 	// Read Terraform prior state data into the model
@@ -337,7 +410,12 @@ func (w ManualWebhookResource) Delete(ctx context.Context, request resource.Dele
 }
 
 func (w ManualWebhookResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	ManualWebhookId := request.ID
+	ManualWebhookId, diags := common.GetImportID(ctx, request)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Importing manual webhook", map[string]interface{}{
 		"id": ManualWebhookId,
 	})
@@ -358,6 +436,7 @@ func (w ManualWebhookResource) ImportState(ctx context.Context, request resource
 
 	// Save imported data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+	response.Diagnostics.Append(common.SetResourceIdentity(ctx, response.Identity, model.ID, model.Name)...)
 
 	tflog.Debug(ctx, "Successfully imported manual webhook", map[string]interface{}{
 		"id": ManualWebhookId,
@@ -405,3 +484,24 @@ func (w ManualWebhookResource) ValidateConfig(ctx context.Context, req resource.
 		)
 	}
 }
+
+// getManualWebhookWriteOnlyAttributes reads the write-only attributes from the config into the plan data,
+// since Terraform doesn't include them in the plan.
+func getManualWebhookWriteOnlyAttributes(ctx context.Context, config tfsdk.Config, data *models.ManualWebhookModel) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+	if data == nil {
+		return diagnostics
+	}
+
+	if data.Type.HttpRequest != nil {
+		headersPath := path.Root("type").AtName("http_request").AtName("headers_wo")
+		diagnostics.Append(config.GetAttribute(ctx, headersPath, &data.Type.HttpRequest.HeadersWO)...)
+	}
+
+	if data.AuthenticationConfig != nil && data.AuthenticationConfig.Oauth != nil {
+		clientSecretPath := path.Root("authentication_config").AtName("oauth").AtName("client_secret_wo")
+		diagnostics.Append(config.GetAttribute(ctx, clientSecretPath, &data.AuthenticationConfig.Oauth.ClientSecretWO)...)
+	}
+
+	return diagnostics
+}
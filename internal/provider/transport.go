@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// transportConfig holds the TLS and proxy settings shared by all Apono API clients.
+type transportConfig struct {
+	CACertFile         string
+	CACertPEM          string
+	ClientCert         string
+	ClientKey          string
+	ProxyURL           string
+	InsecureSkipVerify bool
+}
+
+func (c transportConfig) isDefault() bool {
+	return c == transportConfig{}
+}
+
+// newHTTPTransport builds the base round tripper used by the v1, terraform and v2 API clients.
+// When no TLS or proxy settings are configured, http.DefaultTransport is returned unchanged.
+func newHTTPTransport(cfg transportConfig) (http.RoundTripper, error) {
+	if cfg.isDefault() {
+		return http.DefaultTransport, nil
+	}
+
+	var transport *http.Transport
+	if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = defaultTransport.Clone()
+	} else {
+		transport = &http.Transport{Proxy: http.ProxyFromEnvironment}
+	}
+
+	tlsConfig, err := newTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if cfg.ProxyURL != "" {
+		proxyURL, err := url.Parse(cfg.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse proxy_url %s: %w", cfg.ProxyURL, err)
+		}
+
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("proxy_url %s must be an absolute URL, e.g. http://proxy.example.com:3128", cfg.ProxyURL)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return transport, nil
+}
+
+func newTLSConfig(cfg transportConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	caPEM := []byte(cfg.CACertPEM)
+	if cfg.CACertFile != "" {
+		fileContent, err := os.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca_cert_file %s: %w", cfg.CACertFile, err)
+		}
+		caPEM = fileContent
+	}
+
+	if len(caPEM) > 0 {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		if !rootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("no valid PEM-encoded certificates found in the configured CA certificate")
+		}
+
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if cfg.ClientCert != "" || cfg.ClientKey != "" {
+		if cfg.ClientCert == "" || cfg.ClientKey == "" {
+			return nil, errors.New("client_cert and client_key must be configured together")
+		}
+
+		certificate, err := tls.X509KeyPair([]byte(cfg.ClientCert), []byte(cfg.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generateTestCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "apono-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return string(certPEM), string(keyPEM)
+}
+
+func TestNewHTTPTransport(t *testing.T) {
+	certPEM, keyPEM := generateTestCertificate(t)
+
+	t.Run("DefaultConfigUsesDefaultTransport", func(t *testing.T) {
+		transport, err := newHTTPTransport(transportConfig{})
+		require.NoError(t, err)
+		assert.Equal(t, http.DefaultTransport, transport)
+	})
+
+	t.Run("CACertPEM", func(t *testing.T) {
+		transport, err := newHTTPTransport(transportConfig{CACertPEM: certPEM})
+		require.NoError(t, err)
+
+		httpTransport, ok := transport.(*http.Transport)
+		require.True(t, ok)
+		require.NotNil(t, httpTransport.TLSClientConfig)
+		assert.NotNil(t, httpTransport.TLSClientConfig.RootCAs)
+		assert.False(t, httpTransport.TLSClientConfig.InsecureSkipVerify)
+	})
+
+	t.Run("CACertFile", func(t *testing.T) {
+		caFile := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, os.WriteFile(caFile, []byte(certPEM), 0o600))
+
+		transport, err := newHTTPTransport(transportConfig{CACertFile: caFile})
+		require.NoError(t, err)
+
+		httpTransport, ok := transport.(*http.Transport)
+		require.True(t, ok)
+		assert.NotNil(t, httpTransport.TLSClientConfig.RootCAs)
+	})
+
+	t.Run("CACertFileMissing", func(t *testing.T) {
+		_, err := newHTTPTransport(transportConfig{CACertFile: filepath.Join(t.TempDir(), "missing.pem")})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to read ca_cert_file")
+	})
+
+	t.Run("InvalidCACertPEM", func(t *testing.T) {
+		_, err := newHTTPTransport(transportConfig{CACertPEM: "not a certificate"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no valid PEM-encoded certificates")
+	})
+
+	t.Run("ClientCertificate", func(t *testing.T) {
+		transport, err := newHTTPTransport(transportConfig{ClientCert: certPEM, ClientKey: keyPEM})
+		require.NoError(t, err)
+
+		httpTransport, ok := transport.(*http.Transport)
+		require.True(t, ok)
+		assert.Len(t, httpTransport.TLSClientConfig.Certificates, 1)
+	})
+
+	t.Run("ClientCertificateWithoutKey", func(t *testing.T) {
+		_, err := newHTTPTransport(transportConfig{ClientCert: certPEM})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be configured together")
+	})
+
+	t.Run("ProxyURL", func(t *testing.T) {
+		transport, err := newHTTPTransport(transportConfig{ProxyURL: "http://proxy.example.com:3128"})
+		require.NoError(t, err)
+
+		httpTransport, ok := transport.(*http.Transport)
+		require.True(t, ok)
+
+		req, err := http.NewRequest(http.MethodGet, "https://api.apono.io/api/v1/users", nil)
+		require.NoError(t, err)
+
+		proxyURL, err := httpTransport.Proxy(req)
+		require.NoError(t, err)
+		assert.Equal(t, "http://proxy.example.com:3128", proxyURL.String())
+	})
+
+	t.Run("InvalidProxyURL", func(t *testing.T) {
+		_, err := newHTTPTransport(transportConfig{ProxyURL: "proxy.example.com"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be an absolute URL")
+	})
+
+	t.Run("InsecureSkipVerify", func(t *testing.T) {
+		transport, err := newHTTPTransport(transportConfig{InsecureSkipVerify: true})
+		require.NoError(t, err)
+
+		httpTransport, ok := transport.(*http.Transport)
+		require.True(t, ok)
+		assert.True(t, httpTransport.TLSClientConfig.InsecureSkipVerify)
+	})
+}
@@ -69,6 +69,23 @@ func ConvertToIntegrationModel(ctx context.Context, integration *aponoapi.Integr
 	return &data, nil
 }
 
+// ConvertToIntegrationResourceModel returns the resource model of an integration read from the API. When metadata_wo is used,
+// only the metadata keys of the prior model are kept, so the write-only values are not stored in the state.
+func ConvertToIntegrationResourceModel(integration *models.IntegrationModel, prior *models.IntegrationResourceModel) *models.IntegrationResourceModel {
+	data := models.IntegrationResourceModel{
+		IntegrationModel:  *integration,
+		MetadataWO:        types.MapNull(types.StringType),
+		MetadataWOVersion: types.Int64Null(),
+	}
+
+	if prior != nil && !prior.MetadataWOVersion.IsNull() {
+		data.Metadata = KeepMapKeys(integration.Metadata, prior.Metadata)
+		data.MetadataWOVersion = prior.MetadataWOVersion
+	}
+
+	return &data
+}
+
 func ConvertIntegrationOwnerToData(owners []aponoapi.IntegrationOwnerTerraform) []models.IntegrationOwner {
 	if owners == nil {
 		return nil
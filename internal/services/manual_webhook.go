@@ -94,8 +94,10 @@ func manualWebhookTypeToModel(ctx context.Context, manualWebhookType aponoapi.We
 
 func manualWebhookHttpRequestTypeToModel(ctx context.Context, httpRequestType aponoapi.WebhookTypeTerraformModelHttpRequest) (*models.ManualWebhookHttpRequestTypeModel, diag.Diagnostics) {
 	httpRequest := models.ManualWebhookHttpRequestTypeModel{
-		Url:    types.StringValue(httpRequestType.GetUrl()),
-		Method: types.StringValue(string(httpRequestType.GetMethod())),
+		Url:              types.StringValue(httpRequestType.GetUrl()),
+		Method:           types.StringValue(string(httpRequestType.GetMethod())),
+		HeadersWO:        types.MapNull(types.StringType),
+		HeadersWOVersion: types.Int64Null(),
 	}
 
 	headersMapValue, diagnostics := types.MapValueFrom(ctx, types.StringType, httpRequestType.GetHeaders())
@@ -133,10 +135,36 @@ func webhookOAuthConfigToModel(oauthConfig aponoapi.WebhookAuthenticationConfigT
 	}
 
 	return &models.WebhookOAuthConfigModel{
-		ClientId:         types.StringValue(oauthConfig.GetClientId()),
-		ClientSecret:     types.StringValue(oauthConfig.GetClientSecret()),
-		TokenEndpointUrl: types.StringValue(oauthConfig.GetTokenEndpointUrl()),
-		Scopes:           scopes,
+		ClientId:              types.StringValue(oauthConfig.GetClientId()),
+		ClientSecret:          types.StringValue(oauthConfig.GetClientSecret()),
+		ClientSecretWO:        types.StringNull(),
+		ClientSecretWOVersion: types.Int64Null(),
+		TokenEndpointUrl:      types.StringValue(oauthConfig.GetTokenEndpointUrl()),
+		Scopes:                scopes,
+	}
+}
+
+// KeepManualWebhookWriteOnlySecretsOutOfState removes the secrets that were set with headers_wo and client_secret_wo
+// from a manual webhook read from the API, and keeps the versions of the prior model, which the API doesn't return.
+func KeepManualWebhookWriteOnlySecretsOutOfState(manualWebhook *models.ManualWebhookModel, prior *models.ManualWebhookModel) {
+	if prior == nil {
+		return
+	}
+
+	httpRequest, priorHttpRequest := manualWebhook.Type.HttpRequest, prior.Type.HttpRequest
+	if httpRequest != nil && priorHttpRequest != nil && !priorHttpRequest.HeadersWOVersion.IsNull() {
+		httpRequest.Headers = KeepMapKeys(httpRequest.Headers, priorHttpRequest.Headers)
+		httpRequest.HeadersWOVersion = priorHttpRequest.HeadersWOVersion
+	}
+
+	if manualWebhook.AuthenticationConfig == nil || prior.AuthenticationConfig == nil {
+		return
+	}
+
+	oauth, priorOauth := manualWebhook.AuthenticationConfig.Oauth, prior.AuthenticationConfig.Oauth
+	if oauth != nil && priorOauth != nil && !priorOauth.ClientSecretWOVersion.IsNull() {
+		oauth.ClientSecret = types.StringNull()
+		oauth.ClientSecretWOVersion = priorOauth.ClientSecretWOVersion
 	}
 }
 
@@ -210,6 +238,16 @@ func manualWebhookHttpRequestTypeToApi(httpRequestType models.ManualWebhookHttpR
 		data.Headers = headers
 	}
 
+	if !httpRequestType.HeadersWO.IsNull() && !httpRequestType.HeadersWO.IsUnknown() {
+		headers, err := MergeWriteOnlyMap(httpRequestType.Headers, httpRequestType.HeadersWO)
+		if err != nil {
+			diagnostics := diag.Diagnostics{}
+			diagnostics.AddError("Invalid headers_wo", err.Error())
+			return nil, diagnostics
+		}
+		data.Headers = headers
+	}
+
 	return &data, nil
 }
 
@@ -275,9 +313,14 @@ func webhookOAuthConfigToApi(oauthConfig *models.WebhookOAuthConfigModel) *apono
 		scopes = append(scopes, scope.ValueString())
 	}
 
+	clientSecret := oauthConfig.ClientSecret.ValueString()
+	if !oauthConfig.ClientSecretWO.IsNull() {
+		clientSecret = oauthConfig.ClientSecretWO.ValueString()
+	}
+
 	return &aponoapi.WebhookAuthenticationConfigTerraformModelOauth{
 		ClientId:         oauthConfig.ClientId.ValueString(),
-		ClientSecret:     oauthConfig.ClientSecret.ValueString(),
+		ClientSecret:     clientSecret,
 		TokenEndpointUrl: oauthConfig.TokenEndpointUrl.ValueString(),
 		Scopes:           scopes,
 	}
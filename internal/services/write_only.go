@@ -0,0 +1,61 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MergeWriteOnlyMap merges the string values of a map attribute with the values of its write-only variant,
+// e.g. headers and headers_wo. Terraform never stores write-only values, so both maps are merged before they
+// are sent to the API, and KeepMapKeys drops the write-only keys when the merged map is read back.
+// A key set in both maps is an error, since it's unclear which value should be sent.
+func MergeWriteOnlyMap(values types.Map, writeOnlyValues types.Map) (map[string]string, error) {
+	merged := map[string]string{}
+	for key, value := range values.Elements() {
+		strVal, ok := value.(types.String)
+		if !ok {
+			return nil, fmt.Errorf("value for key %s is not a string", key)
+		}
+		merged[key] = strVal.ValueString()
+	}
+
+	var duplicateKeys []string
+	for key, value := range writeOnlyValues.Elements() {
+		strVal, ok := value.(types.String)
+		if !ok {
+			return nil, fmt.Errorf("value for key %s is not a string", key)
+		}
+		if _, exists := merged[key]; exists {
+			duplicateKeys = append(duplicateKeys, key)
+			continue
+		}
+		merged[key] = strVal.ValueString()
+	}
+
+	if len(duplicateKeys) > 0 {
+		sort.Strings(duplicateKeys)
+		return nil, fmt.Errorf("keys %q are set in both the attribute and its write-only variant", duplicateKeys)
+	}
+
+	return merged, nil
+}
+
+// KeepMapKeys returns the elements of values whose keys are in keys, so the values sent with a write-only
+// attribute are not stored in the state. It returns a null map when keys is null.
+func KeepMapKeys(values types.Map, keys types.Map) types.Map {
+	if keys.IsNull() || keys.IsUnknown() {
+		return types.MapNull(types.StringType)
+	}
+
+	elements := map[string]attr.Value{}
+	for key, value := range values.Elements() {
+		if _, ok := keys.Elements()[key]; ok {
+			elements[key] = value
+		}
+	}
+
+	return types.MapValueMust(types.StringType, elements)
+}
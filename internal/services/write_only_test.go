@@ -0,0 +1,46 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stringMap(values map[string]string) types.Map {
+	elements := map[string]attr.Value{}
+	for key, value := range values {
+		elements[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func TestMergeWriteOnlyMap(t *testing.T) {
+	t.Run("MergesBothMaps", func(t *testing.T) {
+		merged, err := MergeWriteOnlyMap(stringMap(map[string]string{"hostname": "db"}), stringMap(map[string]string{"password": "secret"}))
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"hostname": "db", "password": "secret"}, merged)
+	})
+
+	t.Run("NullMaps", func(t *testing.T) {
+		merged, err := MergeWriteOnlyMap(types.MapNull(types.StringType), types.MapNull(types.StringType))
+		require.NoError(t, err)
+		assert.Empty(t, merged)
+	})
+
+	t.Run("DuplicateKey", func(t *testing.T) {
+		_, err := MergeWriteOnlyMap(stringMap(map[string]string{"password": "a"}), stringMap(map[string]string{"password": "b"}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"password"`)
+	})
+}
+
+func TestKeepMapKeys(t *testing.T) {
+	values := stringMap(map[string]string{"hostname": "db", "password": "secret"})
+
+	assert.Equal(t, stringMap(map[string]string{"hostname": "db"}), KeepMapKeys(values, stringMap(map[string]string{"hostname": "other"})))
+	assert.Equal(t, stringMap(map[string]string{}), KeepMapKeys(values, stringMap(map[string]string{})))
+	assert.True(t, KeepMapKeys(values, types.MapNull(types.StringType)).IsNull())
+}
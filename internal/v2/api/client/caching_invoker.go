@@ -0,0 +1,257 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+var _ Invoker = &CachingInvoker{}
+
+// CachingInvoker wraps an Invoker and caches the results of list operations for the lifetime of the provider,
+// so repeated name-based lookups during a single Terraform run are served from memory.
+// Results are keyed by operation and filter parameters, and the whole cache is invalidated on every write
+// made through the invoker or reported via Invalidate. Cached responses are shared and must not be modified.
+type CachingInvoker struct {
+	Invoker
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	ready chan struct{}
+	value any
+	err   error
+}
+
+func NewCachingInvoker(invoker Invoker) *CachingInvoker {
+	return &CachingInvoker{
+		Invoker: invoker,
+		entries: map[string]*cacheEntry{},
+	}
+}
+
+// Invalidate drops all cached list results.
+func (c *CachingInvoker) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = map[string]*cacheEntry{}
+}
+
+// cachedList returns the cached result for the key, or calls fetch once and caches its result.
+// Concurrent callers with the same key wait for the in-flight call instead of issuing their own.
+// Errors are returned to all waiting callers but are not cached.
+func cachedList[T any](ctx context.Context, c *CachingInvoker, key string, fetch func() (*T, error)) (*T, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &cacheEntry{ready: make(chan struct{})}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	if ok {
+		select {
+		case <-entry.ready:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		if entry.err != nil {
+			return nil, entry.err
+		}
+
+		value, _ := entry.value.(*T)
+		return value, nil
+	}
+
+	value, err := fetch()
+	entry.value, entry.err = value, err
+	close(entry.ready)
+
+	if err != nil {
+		c.mu.Lock()
+		if c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+	}
+
+	return value, err
+}
+
+func cacheKey(operation string, params any) string {
+	return fmt.Sprintf("%s:%+v", operation, params)
+}
+
+func (c *CachingInvoker) ListAccessFlowsV2(ctx context.Context, params ListAccessFlowsV2Params) (*PublicApiListResponseAccessFlowPublicV2Model, error) {
+	return cachedList(ctx, c, cacheKey("ListAccessFlowsV2", params), func() (*PublicApiListResponseAccessFlowPublicV2Model, error) {
+		return c.Invoker.ListAccessFlowsV2(ctx, params)
+	})
+}
+
+func (c *CachingInvoker) ListAccessScopesV1(ctx context.Context, params ListAccessScopesV1Params) (*PublicApiListResponseAccessScopePublicV1Model, error) {
+	return cachedList(ctx, c, cacheKey("ListAccessScopesV1", params), func() (*PublicApiListResponseAccessScopePublicV1Model, error) {
+		return c.Invoker.ListAccessScopesV1(ctx, params)
+	})
+}
+
+func (c *CachingInvoker) ListBundlesV2(ctx context.Context, params ListBundlesV2Params) (*PublicApiListResponseBundlePublicV2Model, error) {
+	return cachedList(ctx, c, cacheKey("ListBundlesV2", params), func() (*PublicApiListResponseBundlePublicV2Model, error) {
+		return c.Invoker.ListBundlesV2(ctx, params)
+	})
+}
+
+func (c *CachingInvoker) ListConnectorsV3(ctx context.Context, params ListConnectorsV3Params) (*PublicApiListResponseConnectorPublicV3Model, error) {
+	return cachedList(ctx, c, cacheKey("ListConnectorsV3", params), func() (*PublicApiListResponseConnectorPublicV3Model, error) {
+		return c.Invoker.ListConnectorsV3(ctx, params)
+	})
+}
+
+func (c *CachingInvoker) ListGroupMembersV1(ctx context.Context, params ListGroupMembersV1Params) (*PublicApiListResponseGroupMemberPublicV1Model, error) {
+	return cachedList(ctx, c, cacheKey("ListGroupMembersV1", params), func() (*PublicApiListResponseGroupMemberPublicV1Model, error) {
+		return c.Invoker.ListGroupMembersV1(ctx, params)
+	})
+}
+
+func (c *CachingInvoker) ListGroupsV1(ctx context.Context, params ListGroupsV1Params) (*PublicApiListResponseGroupPublicV1Model, error) {
+	return cachedList(ctx, c, cacheKey("ListGroupsV1", params), func() (*PublicApiListResponseGroupPublicV1Model, error) {
+		return c.Invoker.ListGroupsV1(ctx, params)
+	})
+}
+
+func (c *CachingInvoker) ListIntegrationsV4(ctx context.Context, params ListIntegrationsV4Params) (*PublicApiListResponseIntegrationPublicV4Model, error) {
+	return cachedList(ctx, c, cacheKey("ListIntegrationsV4", params), func() (*PublicApiListResponseIntegrationPublicV4Model, error) {
+		return c.Invoker.ListIntegrationsV4(ctx, params)
+	})
+}
+
+func (c *CachingInvoker) ListUsers(ctx context.Context) (*PaginatedResponseUserModel, error) {
+	return cachedList(ctx, c, "ListUsers", func() (*PaginatedResponseUserModel, error) {
+		return c.Invoker.ListUsers(ctx)
+	})
+}
+
+func (c *CachingInvoker) ListUsersV3(ctx context.Context, params ListUsersV3Params) (*PublicApiListResponseUserPublicV3Model, error) {
+	return cachedList(ctx, c, cacheKey("ListUsersV3", params), func() (*PublicApiListResponseUserPublicV3Model, error) {
+		return c.Invoker.ListUsersV3(ctx, params)
+	})
+}
+
+func (c *CachingInvoker) AddGroupMemberV1(ctx context.Context, params AddGroupMemberV1Params) error {
+	defer c.Invalidate()
+	return c.Invoker.AddGroupMemberV1(ctx, params)
+}
+
+func (c *CachingInvoker) CreateAccessFlowV2(ctx context.Context, request *AccessFlowUpsertV2) (*AccessFlowV2, error) {
+	defer c.Invalidate()
+	return c.Invoker.CreateAccessFlowV2(ctx, request)
+}
+
+func (c *CachingInvoker) CreateAccessScopesV1(ctx context.Context, request *UpsertAccessScopeV1) (*AccessScopeV1, error) {
+	defer c.Invalidate()
+	return c.Invoker.CreateAccessScopesV1(ctx, request)
+}
+
+func (c *CachingInvoker) CreateBundleV2(ctx context.Context, request *UpsertBundleV2) (*BundleV2, error) {
+	defer c.Invalidate()
+	return c.Invoker.CreateBundleV2(ctx, request)
+}
+
+func (c *CachingInvoker) CreateGroupV1(ctx context.Context, request *CreateGroupV1) (*GroupV1, error) {
+	defer c.Invalidate()
+	return c.Invoker.CreateGroupV1(ctx, request)
+}
+
+func (c *CachingInvoker) CreateIntegrationV4(ctx context.Context, request *CreateIntegrationV4) (*IntegrationV4, error) {
+	defer c.Invalidate()
+	return c.Invoker.CreateIntegrationV4(ctx, request)
+}
+
+func (c *CachingInvoker) DeleteAccessFlowV2(ctx context.Context, params DeleteAccessFlowV2Params) error {
+	defer c.Invalidate()
+	return c.Invoker.DeleteAccessFlowV2(ctx, params)
+}
+
+func (c *CachingInvoker) DeleteAccessScopesV1(ctx context.Context, params DeleteAccessScopesV1Params) error {
+	defer c.Invalidate()
+	return c.Invoker.DeleteAccessScopesV1(ctx, params)
+}
+
+func (c *CachingInvoker) DeleteBundleV2(ctx context.Context, params DeleteBundleV2Params) error {
+	defer c.Invalidate()
+	return c.Invoker.DeleteBundleV2(ctx, params)
+}
+
+func (c *CachingInvoker) DeleteConnectorV3(ctx context.Context, params DeleteConnectorV3Params) error {
+	defer c.Invalidate()
+	return c.Invoker.DeleteConnectorV3(ctx, params)
+}
+
+func (c *CachingInvoker) DeleteGroupV1(ctx context.Context, params DeleteGroupV1Params) error {
+	defer c.Invalidate()
+	return c.Invoker.DeleteGroupV1(ctx, params)
+}
+
+func (c *CachingInvoker) DeleteIntegrationV4(ctx context.Context, params DeleteIntegrationV4Params) error {
+	defer c.Invalidate()
+	return c.Invoker.DeleteIntegrationV4(ctx, params)
+}
+
+func (c *CachingInvoker) RemoveGroupMemberV1(ctx context.Context, params RemoveGroupMemberV1Params) error {
+	defer c.Invalidate()
+	return c.Invoker.RemoveGroupMemberV1(ctx, params)
+}
+
+func (c *CachingInvoker) UpdateAccessFlowV2(ctx context.Context, request *AccessFlowUpsertV2, params UpdateAccessFlowV2Params) (*AccessFlowV2, error) {
+	defer c.Invalidate()
+	return c.Invoker.UpdateAccessFlowV2(ctx, request, params)
+}
+
+func (c *CachingInvoker) UpdateAccessScopesV1(ctx context.Context, request *UpsertAccessScopeV1, params UpdateAccessScopesV1Params) (*AccessScopeV1, error) {
+	defer c.Invalidate()
+	return c.Invoker.UpdateAccessScopesV1(ctx, request, params)
+}
+
+func (c *CachingInvoker) UpdateBundleV2(ctx context.Context, request *UpsertBundleV2, params UpdateBundleV2Params) (*BundleV2, error) {
+	defer c.Invalidate()
+	return c.Invoker.UpdateBundleV2(ctx, request, params)
+}
+
+func (c *CachingInvoker) UpdateConnectorV3(ctx context.Context, request *UpsertConnectorV3, params UpdateConnectorV3Params) (*ConnectorV3, error) {
+	defer c.Invalidate()
+	return c.Invoker.UpdateConnectorV3(ctx, request, params)
+}
+
+func (c *CachingInvoker) UpdateGroupMembersV1(ctx context.Context, request *UpdateGroupMembersV1, params UpdateGroupMembersV1Params) error {
+	defer c.Invalidate()
+	return c.Invoker.UpdateGroupMembersV1(ctx, request, params)
+}
+
+func (c *CachingInvoker) UpdateGroupV1(ctx context.Context, request *UpdateGroupV1, params UpdateGroupV1Params) (*GroupV1, error) {
+	defer c.Invalidate()
+	return c.Invoker.UpdateGroupV1(ctx, request, params)
+}
+
+func (c *CachingInvoker) UpdateIntegrationV4(ctx context.Context, request *UpdateIntegrationV4, params UpdateIntegrationV4Params) (*IntegrationV4, error) {
+	defer c.Invalidate()
+	return c.Invoker.UpdateIntegrationV4(ctx, request, params)
+}
+
+// InvalidatingTransport invalidates the cache whenever a non-GET request is sent, so writes made by
+// clients that don't go through the CachingInvoker (e.g. the legacy v1 clients) are also reflected.
+type InvalidatingTransport struct {
+	Cache     *CachingInvoker
+	Transport http.RoundTripper
+}
+
+func (t *InvalidatingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		defer t.Cache.Invalidate()
+	}
+
+	return t.Transport.RoundTrip(req)
+}
@@ -0,0 +1,123 @@
+package client_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachingInvoker(t *testing.T) {
+	bundles := &client.PublicApiListResponseBundlePublicV2Model{
+		Items: []client.BundleV2{{ID: "bundle-1", Name: "prod"}},
+	}
+
+	t.Run("CachesByFilter", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		c := client.NewCachingInvoker(mockInvoker)
+
+		prodParams := client.ListBundlesV2Params{}
+		prodParams.Name.SetTo("prod")
+		devParams := client.ListBundlesV2Params{}
+		devParams.Name.SetTo("dev")
+
+		mockInvoker.EXPECT().ListBundlesV2(mock.Anything, prodParams).Return(bundles, nil).Once()
+		mockInvoker.EXPECT().ListBundlesV2(mock.Anything, devParams).Return(&client.PublicApiListResponseBundlePublicV2Model{}, nil).Once()
+
+		for range 3 {
+			resp, err := c.ListBundlesV2(t.Context(), prodParams)
+			require.NoError(t, err)
+			assert.Equal(t, bundles, resp)
+		}
+
+		resp, err := c.ListBundlesV2(t.Context(), devParams)
+		require.NoError(t, err)
+		assert.Empty(t, resp.Items)
+	})
+
+	t.Run("InvalidatedOnWrite", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		c := client.NewCachingInvoker(mockInvoker)
+
+		mockInvoker.EXPECT().ListBundlesV2(mock.Anything, mock.Anything).Return(bundles, nil).Twice()
+		mockInvoker.EXPECT().DeleteBundleV2(mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, err := c.ListBundlesV2(t.Context(), client.ListBundlesV2Params{})
+		require.NoError(t, err)
+
+		require.NoError(t, c.DeleteBundleV2(t.Context(), client.DeleteBundleV2Params{ID: "bundle-1"}))
+
+		_, err = c.ListBundlesV2(t.Context(), client.ListBundlesV2Params{})
+		require.NoError(t, err)
+	})
+
+	t.Run("ErrorsAreNotCached", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		c := client.NewCachingInvoker(mockInvoker)
+
+		mockInvoker.EXPECT().ListGroupsV1(mock.Anything, mock.Anything).Return(nil, errors.New("boom")).Once()
+		mockInvoker.EXPECT().ListGroupsV1(mock.Anything, mock.Anything).Return(&client.PublicApiListResponseGroupPublicV1Model{}, nil).Once()
+
+		_, err := c.ListGroupsV1(t.Context(), client.ListGroupsV1Params{})
+		require.Error(t, err)
+
+		_, err = c.ListGroupsV1(t.Context(), client.ListGroupsV1Params{})
+		require.NoError(t, err)
+	})
+
+	t.Run("ConcurrentLookups", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		c := client.NewCachingInvoker(mockInvoker)
+
+		mockInvoker.EXPECT().ListIntegrationsV4(mock.Anything, mock.Anything).
+			Return(&client.PublicApiListResponseIntegrationPublicV4Model{}, nil).Once()
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := c.ListIntegrationsV4(t.Context(), client.ListIntegrationsV4Params{})
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+	})
+
+	t.Run("InvalidatingTransport", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		mockInvoker := mocks.NewInvoker(t)
+		c := client.NewCachingInvoker(mockInvoker)
+		mockInvoker.EXPECT().ListBundlesV2(mock.Anything, mock.Anything).Return(bundles, nil).Twice()
+
+		httpClient := &http.Client{Transport: &client.InvalidatingTransport{Cache: c, Transport: http.DefaultTransport}}
+
+		_, err := c.ListBundlesV2(t.Context(), client.ListBundlesV2Params{})
+		require.NoError(t, err)
+
+		getResp, err := httpClient.Get(server.URL)
+		require.NoError(t, err)
+		_ = getResp.Body.Close()
+
+		_, err = c.ListBundlesV2(t.Context(), client.ListBundlesV2Params{})
+		require.NoError(t, err)
+
+		postResp, err := httpClient.Post(server.URL, "application/json", nil)
+		require.NoError(t, err)
+		_ = postResp.Body.Close()
+
+		_, err = c.ListBundlesV2(t.Context(), client.ListBundlesV2Params{})
+		require.NoError(t, err)
+	})
+}
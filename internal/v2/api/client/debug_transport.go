@@ -2,7 +2,6 @@ package client
 
 import (
 	"bytes"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -75,7 +74,7 @@ func (t *DebugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 			}
 		}
 
-		return resp, errors.New(errorDetails)
+		return resp, &APIError{StatusCode: resp.StatusCode, Details: errorDetails}
 	}
 
 	return resp, err
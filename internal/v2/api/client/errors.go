@@ -2,6 +2,7 @@ package client
 
 import (
 	"errors"
+	"net/http"
 )
 
 // NotFoundError represents a 404 Not Found error.
@@ -15,3 +16,23 @@ func IsNotFoundError(err error) bool {
 	var notFoundErr *NotFoundError
 	return errors.As(err, &notFoundErr)
 }
+
+// APIError represents an unsuccessful API response other than 404 Not Found.
+type APIError struct {
+	StatusCode int
+	Details    string
+}
+
+func (e *APIError) Error() string {
+	return e.Details
+}
+
+// IsUnauthorizedError reports whether the API rejected the request credentials.
+func IsUnauthorizedError(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	return apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden
+}
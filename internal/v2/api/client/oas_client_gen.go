@@ -125,6 +125,12 @@ type Invoker interface {
 	//
 	// GET /api/admin/v1/groups/{id}
 	GetGroupV1(ctx context.Context, params GetGroupV1Params) (*GroupV1, error)
+	// GetIntegrationPermissions invokes getIntegrationPermissions operation.
+	//
+	// Get integration permissions for the entire tenant.
+	//
+	// GET /api/v3/integrations/{id}/permissions
+	GetIntegrationPermissions(ctx context.Context, params GetIntegrationPermissionsParams) (*PaginatedResponsePermissionV3Response, error)
 	// GetIntegrationsByIdV4 invokes getIntegrationsByIdV4 operation.
 	//
 	// Get Integration By Id.
@@ -137,6 +143,12 @@ type Invoker interface {
 	//
 	// GET /api/v2/users/{id}
 	GetUser(ctx context.Context, params GetUserParams) (*UserModel, error)
+	// GetUserV3 invokes getUserV3 operation.
+	//
+	// Get User.
+	//
+	// GET /api/admin/v3/users/{id}
+	GetUserV3(ctx context.Context, params GetUserV3Params) (*UserV3, error)
 	// ListAccessFlowsV2 invokes listAccessFlowsV2 operation.
 	//
 	// List Access Flows.
@@ -185,6 +197,12 @@ type Invoker interface {
 	//
 	// GET /api/v2/users
 	ListUsers(ctx context.Context) (*PaginatedResponseUserModel, error)
+	// ListUsersV3 invokes listUsersV3 operation.
+	//
+	// List Users.
+	//
+	// GET /api/admin/v3/users
+	ListUsersV3(ctx context.Context, params ListUsersV3Params) (*PublicApiListResponseUserPublicV3Model, error)
 	// RemoveGroupMemberV1 invokes removeGroupMemberV1 operation.
 	//
 	// Remove Group Member.
@@ -1754,6 +1772,115 @@ func (c *Client) sendGetGroupV1(ctx context.Context, params GetGroupV1Params) (r
 	return result, nil
 }
 
+// GetIntegrationPermissions invokes getIntegrationPermissions operation.
+//
+// Get integration permissions for the entire tenant.
+//
+// GET /api/v3/integrations/{id}/permissions
+func (c *Client) GetIntegrationPermissions(ctx context.Context, params GetIntegrationPermissionsParams) (*PaginatedResponsePermissionV3Response, error) {
+	res, err := c.sendGetIntegrationPermissions(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetIntegrationPermissions(ctx context.Context, params GetIntegrationPermissionsParams) (res *PaginatedResponsePermissionV3Response, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/api/v3/integrations/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/permissions"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "resource-type" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "resource-type",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.ResourceType.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityAuthorization(ctx, GetIntegrationPermissionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Authorization\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	result, err := decodeGetIntegrationPermissionsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // GetIntegrationsByIdV4 invokes getIntegrationsByIdV4 operation.
 //
 // Get Integration By Id.
@@ -1930,6 +2057,94 @@ func (c *Client) sendGetUser(ctx context.Context, params GetUserParams) (res *Us
 	return result, nil
 }
 
+// GetUserV3 invokes getUserV3 operation.
+//
+// Get User.
+//
+// GET /api/admin/v3/users/{id}
+func (c *Client) GetUserV3(ctx context.Context, params GetUserV3Params) (*UserV3, error) {
+	res, err := c.sendGetUserV3(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetUserV3(ctx context.Context, params GetUserV3Params) (res *UserV3, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/api/admin/v3/users/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityAuthorization(ctx, GetUserV3Operation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Authorization\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	result, err := decodeGetUserV3Response(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // ListAccessFlowsV2 invokes listAccessFlowsV2 operation.
 //
 // List Access Flows.
@@ -2966,6 +3181,207 @@ func (c *Client) sendListUsers(ctx context.Context) (res *PaginatedResponseUserM
 	return result, nil
 }
 
+// ListUsersV3 invokes listUsersV3 operation.
+//
+// List Users.
+//
+// GET /api/admin/v3/users
+func (c *Client) ListUsersV3(ctx context.Context, params ListUsersV3Params) (*PublicApiListResponseUserPublicV3Model, error) {
+	res, err := c.sendListUsersV3(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendListUsersV3(ctx context.Context, params ListUsersV3Params) (res *PublicApiListResponseUserPublicV3Model, err error) {
+
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/api/admin/v3/users"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "first_name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "first_name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.FirstName.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "last_name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "last_name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.LastName.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "limit" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Limit.Get(); ok {
+				return e.EncodeValue(conv.Int32ToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "page_token" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "page_token",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.PageToken.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "role" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "role",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Role.Get(); ok {
+				return e.EncodeArray(func(e uri.Encoder) error {
+					for i, item := range val {
+						if err := func() error {
+							return e.EncodeValue(conv.StringToString(item))
+						}(); err != nil {
+							return errors.Wrapf(err, "[%d]", i)
+						}
+					}
+					return nil
+				})
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "source_integration_id" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "source_integration_id",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.SourceIntegrationID.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "source_integration_name" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "source_integration_name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.SourceIntegrationName.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+
+			switch err := c.securityAuthorization(ctx, ListUsersV3Operation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"Authorization\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	body := resp.Body
+	defer body.Close()
+
+	result, err := decodeListUsersV3Response(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // RemoveGroupMemberV1 invokes removeGroupMemberV1 operation.
 //
 // Remove Group Member.
@@ -6,39 +6,42 @@ package client
 type OperationName = string
 
 const (
-	AddGroupMemberV1Operation      OperationName = "AddGroupMemberV1"
-	CreateAccessFlowV2Operation    OperationName = "CreateAccessFlowV2"
-	CreateAccessScopesV1Operation  OperationName = "CreateAccessScopesV1"
-	CreateBundleV2Operation        OperationName = "CreateBundleV2"
-	CreateGroupV1Operation         OperationName = "CreateGroupV1"
-	CreateIntegrationV4Operation   OperationName = "CreateIntegrationV4"
-	DeleteAccessFlowV2Operation    OperationName = "DeleteAccessFlowV2"
-	DeleteAccessScopesV1Operation  OperationName = "DeleteAccessScopesV1"
-	DeleteBundleV2Operation        OperationName = "DeleteBundleV2"
-	DeleteConnectorV3Operation     OperationName = "DeleteConnectorV3"
-	DeleteGroupV1Operation         OperationName = "DeleteGroupV1"
-	DeleteIntegrationV4Operation   OperationName = "DeleteIntegrationV4"
-	GetAccessFlowV2Operation       OperationName = "GetAccessFlowV2"
-	GetAccessScopesV1Operation     OperationName = "GetAccessScopesV1"
-	GetBundleV2Operation           OperationName = "GetBundleV2"
-	GetConnectorV3Operation        OperationName = "GetConnectorV3"
-	GetGroupV1Operation            OperationName = "GetGroupV1"
-	GetIntegrationsByIdV4Operation OperationName = "GetIntegrationsByIdV4"
-	GetUserOperation               OperationName = "GetUser"
-	ListAccessFlowsV2Operation     OperationName = "ListAccessFlowsV2"
-	ListAccessScopesV1Operation    OperationName = "ListAccessScopesV1"
-	ListBundlesV2Operation         OperationName = "ListBundlesV2"
-	ListConnectorsV3Operation      OperationName = "ListConnectorsV3"
-	ListGroupMembersV1Operation    OperationName = "ListGroupMembersV1"
-	ListGroupsV1Operation          OperationName = "ListGroupsV1"
-	ListIntegrationsV4Operation    OperationName = "ListIntegrationsV4"
-	ListUsersOperation             OperationName = "ListUsers"
-	RemoveGroupMemberV1Operation   OperationName = "RemoveGroupMemberV1"
-	UpdateAccessFlowV2Operation    OperationName = "UpdateAccessFlowV2"
-	UpdateAccessScopesV1Operation  OperationName = "UpdateAccessScopesV1"
-	UpdateBundleV2Operation        OperationName = "UpdateBundleV2"
-	UpdateConnectorV3Operation     OperationName = "UpdateConnectorV3"
-	UpdateGroupMembersV1Operation  OperationName = "UpdateGroupMembersV1"
-	UpdateGroupV1Operation         OperationName = "UpdateGroupV1"
-	UpdateIntegrationV4Operation   OperationName = "UpdateIntegrationV4"
+	AddGroupMemberV1Operation          OperationName = "AddGroupMemberV1"
+	CreateAccessFlowV2Operation        OperationName = "CreateAccessFlowV2"
+	CreateAccessScopesV1Operation      OperationName = "CreateAccessScopesV1"
+	CreateBundleV2Operation            OperationName = "CreateBundleV2"
+	CreateGroupV1Operation             OperationName = "CreateGroupV1"
+	CreateIntegrationV4Operation       OperationName = "CreateIntegrationV4"
+	DeleteAccessFlowV2Operation        OperationName = "DeleteAccessFlowV2"
+	DeleteAccessScopesV1Operation      OperationName = "DeleteAccessScopesV1"
+	DeleteBundleV2Operation            OperationName = "DeleteBundleV2"
+	DeleteConnectorV3Operation         OperationName = "DeleteConnectorV3"
+	DeleteGroupV1Operation             OperationName = "DeleteGroupV1"
+	DeleteIntegrationV4Operation       OperationName = "DeleteIntegrationV4"
+	GetAccessFlowV2Operation           OperationName = "GetAccessFlowV2"
+	GetAccessScopesV1Operation         OperationName = "GetAccessScopesV1"
+	GetBundleV2Operation               OperationName = "GetBundleV2"
+	GetConnectorV3Operation            OperationName = "GetConnectorV3"
+	GetGroupV1Operation                OperationName = "GetGroupV1"
+	GetIntegrationPermissionsOperation OperationName = "GetIntegrationPermissions"
+	GetIntegrationsByIdV4Operation     OperationName = "GetIntegrationsByIdV4"
+	GetUserOperation                   OperationName = "GetUser"
+	GetUserV3Operation                 OperationName = "GetUserV3"
+	ListAccessFlowsV2Operation         OperationName = "ListAccessFlowsV2"
+	ListAccessScopesV1Operation        OperationName = "ListAccessScopesV1"
+	ListBundlesV2Operation             OperationName = "ListBundlesV2"
+	ListConnectorsV3Operation          OperationName = "ListConnectorsV3"
+	ListGroupMembersV1Operation        OperationName = "ListGroupMembersV1"
+	ListGroupsV1Operation              OperationName = "ListGroupsV1"
+	ListIntegrationsV4Operation        OperationName = "ListIntegrationsV4"
+	ListUsersOperation                 OperationName = "ListUsers"
+	ListUsersV3Operation               OperationName = "ListUsersV3"
+	RemoveGroupMemberV1Operation       OperationName = "RemoveGroupMemberV1"
+	UpdateAccessFlowV2Operation        OperationName = "UpdateAccessFlowV2"
+	UpdateAccessScopesV1Operation      OperationName = "UpdateAccessScopesV1"
+	UpdateBundleV2Operation            OperationName = "UpdateBundleV2"
+	UpdateConnectorV3Operation         OperationName = "UpdateConnectorV3"
+	UpdateGroupMembersV1Operation      OperationName = "UpdateGroupMembersV1"
+	UpdateGroupV1Operation             OperationName = "UpdateGroupV1"
+	UpdateIntegrationV4Operation       OperationName = "UpdateIntegrationV4"
 )
@@ -63,6 +63,12 @@ type GetGroupV1Params struct {
 	ID string
 }
 
+// GetIntegrationPermissionsParams is parameters of getIntegrationPermissions operation.
+type GetIntegrationPermissionsParams struct {
+	ID           string
+	ResourceType OptNilString `json:",omitempty,omitzero"`
+}
+
 // GetIntegrationsByIdV4Params is parameters of getIntegrationsByIdV4 operation.
 type GetIntegrationsByIdV4Params struct {
 	ID string
@@ -73,6 +79,11 @@ type GetUserParams struct {
 	ID string
 }
 
+// GetUserV3Params is parameters of getUserV3 operation.
+type GetUserV3Params struct {
+	ID string
+}
+
 // ListAccessFlowsV2Params is parameters of listAccessFlowsV2 operation.
 type ListAccessFlowsV2Params struct {
 	Limit     OptInt32     `json:",omitempty,omitzero"`
@@ -135,6 +146,21 @@ type ListIntegrationsV4Params struct {
 	Type OptNilStringArray `json:",omitempty,omitzero"`
 }
 
+// ListUsersV3Params is parameters of listUsersV3 operation.
+type ListUsersV3Params struct {
+	// Filter users by first name. Supports wildcard (*) for partial matches - use * for contains,
+	// prefix* for starts with, *suffix for ends with.
+	FirstName OptNilString `json:",omitempty,omitzero"`
+	// Filter users by last name. Supports wildcard (*) for partial matches - use * for contains, prefix*
+	// for starts with, *suffix for ends with.
+	LastName              OptNilString      `json:",omitempty,omitzero"`
+	Limit                 OptInt32          `json:",omitempty,omitzero"`
+	PageToken             OptNilString      `json:",omitempty,omitzero"`
+	Role                  OptNilStringArray `json:",omitempty,omitzero"`
+	SourceIntegrationID   OptNilString      `json:",omitempty,omitzero"`
+	SourceIntegrationName OptNilString      `json:",omitempty,omitzero"`
+}
+
 // RemoveGroupMemberV1Params is parameters of removeGroupMemberV1 operation.
 type RemoveGroupMemberV1Params struct {
 	Email string
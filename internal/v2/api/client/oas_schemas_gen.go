@@ -4538,6 +4538,69 @@ func (o OptNilUpsertSecretStoreConfigV4) Or(d UpsertSecretStoreConfigV4) UpsertS
 	return d
 }
 
+// NewOptNilUserV3Attributes returns new OptNilUserV3Attributes with value set to v.
+func NewOptNilUserV3Attributes(v UserV3Attributes) OptNilUserV3Attributes {
+	return OptNilUserV3Attributes{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilUserV3Attributes is optional nullable UserV3Attributes.
+type OptNilUserV3Attributes struct {
+	Value UserV3Attributes
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilUserV3Attributes was set.
+func (o OptNilUserV3Attributes) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilUserV3Attributes) Reset() {
+	var v UserV3Attributes
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilUserV3Attributes) SetTo(v UserV3Attributes) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilUserV3Attributes) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilUserV3Attributes) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v UserV3Attributes
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilUserV3Attributes) Get() (v UserV3Attributes, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilUserV3Attributes) Or(d UserV3Attributes) UserV3Attributes {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // Group or role responsible for approving or rejecting access to the resource.
 // Ref: #/components/schemas/OwnerMappingV4
 type OwnerMappingV4 struct {
@@ -4647,6 +4710,32 @@ func (s *OwnerV4) SetSourceIntegrationName(val OptNilString) {
 	s.SourceIntegrationName = val
 }
 
+// Ref: #/components/schemas/PaginatedResponsePermissionV3Response
+type PaginatedResponsePermissionV3Response struct {
+	Data       []PermissionV3 `json:"data"`
+	Pagination PaginationInfo `json:"pagination"`
+}
+
+// GetData returns the value of Data.
+func (s *PaginatedResponsePermissionV3Response) GetData() []PermissionV3 {
+	return s.Data
+}
+
+// GetPagination returns the value of Pagination.
+func (s *PaginatedResponsePermissionV3Response) GetPagination() PaginationInfo {
+	return s.Pagination
+}
+
+// SetData sets the value of Data.
+func (s *PaginatedResponsePermissionV3Response) SetData(val []PermissionV3) {
+	s.Data = val
+}
+
+// SetPagination sets the value of Pagination.
+func (s *PaginatedResponsePermissionV3Response) SetPagination(val PaginationInfo) {
+	s.Pagination = val
+}
+
 // Ref: #/components/schemas/PaginatedResponseUserModel
 type PaginatedResponseUserModel struct {
 	Data       []UserModel    `json:"data"`
@@ -4710,6 +4799,43 @@ func (s *PaginationInfo) SetOffset(val int32) {
 	s.Offset = val
 }
 
+// Ref: #/components/schemas/PermissionV3
+type PermissionV3 struct {
+	Name         string `json:"name"`
+	ID           string `json:"id"`
+	ResourceType string `json:"resource_type"`
+}
+
+// GetName returns the value of Name.
+func (s *PermissionV3) GetName() string {
+	return s.Name
+}
+
+// GetID returns the value of ID.
+func (s *PermissionV3) GetID() string {
+	return s.ID
+}
+
+// GetResourceType returns the value of ResourceType.
+func (s *PermissionV3) GetResourceType() string {
+	return s.ResourceType
+}
+
+// SetName sets the value of Name.
+func (s *PermissionV3) SetName(val string) {
+	s.Name = val
+}
+
+// SetID sets the value of ID.
+func (s *PermissionV3) SetID(val string) {
+	s.ID = val
+}
+
+// SetResourceType sets the value of ResourceType.
+func (s *PermissionV3) SetResourceType(val string) {
+	s.ResourceType = val
+}
+
 // Ref: #/components/schemas/PublicApiListResponseAccessFlowPublicV2Model
 type PublicApiListResponseAccessFlowPublicV2Model struct {
 	Items      []AccessFlowV2               `json:"items"`
@@ -4892,6 +5018,32 @@ func (s *PublicApiListResponseIntegrationPublicV4Model) SetPagination(val Public
 	s.Pagination = val
 }
 
+// Ref: #/components/schemas/PublicApiListResponseUserPublicV3Model
+type PublicApiListResponseUserPublicV3Model struct {
+	Items      []UserV3                     `json:"items"`
+	Pagination PublicApiPaginationInfoModel `json:"pagination"`
+}
+
+// GetItems returns the value of Items.
+func (s *PublicApiListResponseUserPublicV3Model) GetItems() []UserV3 {
+	return s.Items
+}
+
+// GetPagination returns the value of Pagination.
+func (s *PublicApiListResponseUserPublicV3Model) GetPagination() PublicApiPaginationInfoModel {
+	return s.Pagination
+}
+
+// SetItems sets the value of Items.
+func (s *PublicApiListResponseUserPublicV3Model) SetItems(val []UserV3) {
+	s.Items = val
+}
+
+// SetPagination sets the value of Pagination.
+func (s *PublicApiListResponseUserPublicV3Model) SetPagination(val PublicApiPaginationInfoModel) {
+	s.Pagination = val
+}
+
 // Ref: #/components/schemas/PublicApiPaginationInfoModel
 type PublicApiPaginationInfoModel struct {
 	// Token used to retrieve the next page of results in a paginated response.
@@ -5594,3 +5746,142 @@ func (s *UserModel) SetLastName(val string) {
 func (s *UserModel) SetActive(val bool) {
 	s.Active = val
 }
+
+// Ref: #/components/schemas/UserV3
+type UserV3 struct {
+	// Unique identifier of the user.
+	ID string `json:"id"`
+	// The user’s primary email address.
+	Email string `json:"email"`
+	// A list of additional email addresses associated with the user.
+	EmailAliases []string `json:"email_aliases"`
+	// The user’s first name.
+	FirstName string `json:"first_name"`
+	// The user’s family name or surname.
+	LastName string `json:"last_name"`
+	// Indicates whether the user is currently active within Apono.
+	Active bool `json:"active"`
+	// A list of roles assigned to the user, representing their permissions (e.g. Admin, Power User,
+	// Deployment, Viewer).
+	Roles []string `json:"roles"`
+	// Unique Apono identifier of the integration providing the entity.
+	SourceIntegrationID OptNilString `json:"source_integration_id"`
+	// Display name of the integration providing the entity.
+	SourceIntegrationName OptNilString `json:"source_integration_name"`
+	// A key-value map of custom user attributes retrieved from the source integration. These may include
+	// department, location, title, or any other metadata defined in the source system.
+	Attributes OptNilUserV3Attributes `json:"attributes"`
+}
+
+// GetID returns the value of ID.
+func (s *UserV3) GetID() string {
+	return s.ID
+}
+
+// GetEmail returns the value of Email.
+func (s *UserV3) GetEmail() string {
+	return s.Email
+}
+
+// GetEmailAliases returns the value of EmailAliases.
+func (s *UserV3) GetEmailAliases() []string {
+	return s.EmailAliases
+}
+
+// GetFirstName returns the value of FirstName.
+func (s *UserV3) GetFirstName() string {
+	return s.FirstName
+}
+
+// GetLastName returns the value of LastName.
+func (s *UserV3) GetLastName() string {
+	return s.LastName
+}
+
+// GetActive returns the value of Active.
+func (s *UserV3) GetActive() bool {
+	return s.Active
+}
+
+// GetRoles returns the value of Roles.
+func (s *UserV3) GetRoles() []string {
+	return s.Roles
+}
+
+// GetSourceIntegrationID returns the value of SourceIntegrationID.
+func (s *UserV3) GetSourceIntegrationID() OptNilString {
+	return s.SourceIntegrationID
+}
+
+// GetSourceIntegrationName returns the value of SourceIntegrationName.
+func (s *UserV3) GetSourceIntegrationName() OptNilString {
+	return s.SourceIntegrationName
+}
+
+// GetAttributes returns the value of Attributes.
+func (s *UserV3) GetAttributes() OptNilUserV3Attributes {
+	return s.Attributes
+}
+
+// SetID sets the value of ID.
+func (s *UserV3) SetID(val string) {
+	s.ID = val
+}
+
+// SetEmail sets the value of Email.
+func (s *UserV3) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetEmailAliases sets the value of EmailAliases.
+func (s *UserV3) SetEmailAliases(val []string) {
+	s.EmailAliases = val
+}
+
+// SetFirstName sets the value of FirstName.
+func (s *UserV3) SetFirstName(val string) {
+	s.FirstName = val
+}
+
+// SetLastName sets the value of LastName.
+func (s *UserV3) SetLastName(val string) {
+	s.LastName = val
+}
+
+// SetActive sets the value of Active.
+func (s *UserV3) SetActive(val bool) {
+	s.Active = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *UserV3) SetRoles(val []string) {
+	s.Roles = val
+}
+
+// SetSourceIntegrationID sets the value of SourceIntegrationID.
+func (s *UserV3) SetSourceIntegrationID(val OptNilString) {
+	s.SourceIntegrationID = val
+}
+
+// SetSourceIntegrationName sets the value of SourceIntegrationName.
+func (s *UserV3) SetSourceIntegrationName(val OptNilString) {
+	s.SourceIntegrationName = val
+}
+
+// SetAttributes sets the value of Attributes.
+func (s *UserV3) SetAttributes(val OptNilUserV3Attributes) {
+	s.Attributes = val
+}
+
+// A key-value map of custom user attributes retrieved from the source integration. These may include
+// department, location, title, or any other metadata defined in the source system.
+type UserV3Attributes map[string]string
+
+func (s *UserV3Attributes) init() UserV3Attributes {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
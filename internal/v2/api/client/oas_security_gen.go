@@ -17,41 +17,44 @@ type SecuritySource interface {
 
 // operationRolesAuthorization is a private map storing roles per operation.
 var operationRolesAuthorization = map[string][]string{
-	AddGroupMemberV1Operation:      []string{},
-	CreateAccessFlowV2Operation:    []string{},
-	CreateAccessScopesV1Operation:  []string{},
-	CreateBundleV2Operation:        []string{},
-	CreateGroupV1Operation:         []string{},
-	CreateIntegrationV4Operation:   []string{},
-	DeleteAccessFlowV2Operation:    []string{},
-	DeleteAccessScopesV1Operation:  []string{},
-	DeleteBundleV2Operation:        []string{},
-	DeleteConnectorV3Operation:     []string{},
-	DeleteGroupV1Operation:         []string{},
-	DeleteIntegrationV4Operation:   []string{},
-	GetAccessFlowV2Operation:       []string{},
-	GetAccessScopesV1Operation:     []string{},
-	GetBundleV2Operation:           []string{},
-	GetConnectorV3Operation:        []string{},
-	GetGroupV1Operation:            []string{},
-	GetIntegrationsByIdV4Operation: []string{},
-	GetUserOperation:               []string{},
-	ListAccessFlowsV2Operation:     []string{},
-	ListAccessScopesV1Operation:    []string{},
-	ListBundlesV2Operation:         []string{},
-	ListConnectorsV3Operation:      []string{},
-	ListGroupMembersV1Operation:    []string{},
-	ListGroupsV1Operation:          []string{},
-	ListIntegrationsV4Operation:    []string{},
-	ListUsersOperation:             []string{},
-	RemoveGroupMemberV1Operation:   []string{},
-	UpdateAccessFlowV2Operation:    []string{},
-	UpdateAccessScopesV1Operation:  []string{},
-	UpdateBundleV2Operation:        []string{},
-	UpdateConnectorV3Operation:     []string{},
-	UpdateGroupMembersV1Operation:  []string{},
-	UpdateGroupV1Operation:         []string{},
-	UpdateIntegrationV4Operation:   []string{},
+	AddGroupMemberV1Operation:          []string{},
+	CreateAccessFlowV2Operation:        []string{},
+	CreateAccessScopesV1Operation:      []string{},
+	CreateBundleV2Operation:            []string{},
+	CreateGroupV1Operation:             []string{},
+	CreateIntegrationV4Operation:       []string{},
+	DeleteAccessFlowV2Operation:        []string{},
+	DeleteAccessScopesV1Operation:      []string{},
+	DeleteBundleV2Operation:            []string{},
+	DeleteConnectorV3Operation:         []string{},
+	DeleteGroupV1Operation:             []string{},
+	DeleteIntegrationV4Operation:       []string{},
+	GetAccessFlowV2Operation:           []string{},
+	GetAccessScopesV1Operation:         []string{},
+	GetBundleV2Operation:               []string{},
+	GetConnectorV3Operation:            []string{},
+	GetGroupV1Operation:                []string{},
+	GetIntegrationPermissionsOperation: []string{},
+	GetIntegrationsByIdV4Operation:     []string{},
+	GetUserOperation:                   []string{},
+	GetUserV3Operation:                 []string{},
+	ListAccessFlowsV2Operation:         []string{},
+	ListAccessScopesV1Operation:        []string{},
+	ListBundlesV2Operation:             []string{},
+	ListConnectorsV3Operation:          []string{},
+	ListGroupMembersV1Operation:        []string{},
+	ListGroupsV1Operation:              []string{},
+	ListIntegrationsV4Operation:        []string{},
+	ListUsersOperation:                 []string{},
+	ListUsersV3Operation:               []string{},
+	RemoveGroupMemberV1Operation:       []string{},
+	UpdateAccessFlowV2Operation:        []string{},
+	UpdateAccessScopesV1Operation:      []string{},
+	UpdateBundleV2Operation:            []string{},
+	UpdateConnectorV3Operation:         []string{},
+	UpdateGroupMembersV1Operation:      []string{},
+	UpdateGroupV1Operation:             []string{},
+	UpdateIntegrationV4Operation:       []string{},
 }
 
 // GetRolesForAuthorization returns the required roles for the given operation.
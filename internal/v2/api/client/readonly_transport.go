@@ -0,0 +1,23 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ReadOnlyTransport rejects every request that is not a GET, so a read-only provider cannot modify the tenant.
+type ReadOnlyTransport struct {
+	Transport http.RoundTripper
+}
+
+func (t *ReadOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+
+		return nil, fmt.Errorf("%s %s was blocked because the provider is configured as read-only", req.Method, req.URL.Path)
+	}
+
+	return t.Transport.RoundTrip(req)
+}
@@ -0,0 +1,138 @@
+package aql
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Quote quotes a value, escaping backslashes and double quotes.
+func Quote(value string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+	return `"` + escaped + `"`
+}
+
+// Build builds an expression comparing a field to values. Multiple values are combined with or for = and contains,
+// and with and for != and not contains.
+func Build(field, operator string, values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = Quote(value)
+	}
+
+	if operator == "in" || operator == "not in" {
+		return fmt.Sprintf("%s %s (%s)", field, operator, strings.Join(quoted, ", "))
+	}
+
+	if len(quoted) == 1 {
+		return fmt.Sprintf("%s %s %s", field, operator, quoted[0])
+	}
+
+	logicalOperator := " or "
+	if operator == "!=" || operator == "not contains" {
+		logicalOperator = " and "
+	}
+
+	comparisons := make([]string, len(quoted))
+	for i, value := range quoted {
+		comparisons[i] = fmt.Sprintf("%s %s %s", field, operator, value)
+	}
+
+	return "(" + strings.Join(comparisons, logicalOperator) + ")"
+}
+
+// Filter is a structured query, compiled into AQL by Compile. Each non-empty condition matches resources with any
+// of its values, and the conditions and groups are combined with the operator.
+type Filter struct {
+	// Operator is "and" or "or". It defaults to "and".
+	Operator string
+
+	Integrations         []string
+	ResourceTypes        []string
+	Tags                 []Tag
+	Permissions          []string
+	ResourceNameContains []string
+
+	Groups []Filter
+}
+
+// Tag matches resources with any of the values for the tag key.
+type Tag struct {
+	Key    string
+	Values []string
+}
+
+// Compile compiles the filter into an AQL query. Conditions are written in a fixed order and their values are
+// sorted, so the same filter always compiles into the same query.
+func (f Filter) Compile() (string, error) {
+	terms, err := f.terms()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(terms, " "+f.operator()+" "), nil
+}
+
+func (f Filter) operator() string {
+	if f.Operator == "" {
+		return "and"
+	}
+
+	return strings.ToLower(f.Operator)
+}
+
+func (f Filter) terms() ([]string, error) {
+	if operator := f.operator(); operator != "and" && operator != "or" {
+		return nil, fmt.Errorf("invalid operator %q: possible values are and, or", f.Operator)
+	}
+
+	var terms []string
+	in := func(field string, values []string) {
+		values = sortedUnique(values)
+		switch len(values) {
+		case 0:
+		case 1:
+			terms = append(terms, Build(field, "=", values))
+		default:
+			terms = append(terms, Build(field, "in", values))
+		}
+	}
+
+	in("integration", f.Integrations)
+	in("resource_type", f.ResourceTypes)
+	for _, tag := range f.Tags {
+		if tag.Key == "" {
+			return nil, errors.New("tag key must not be empty")
+		}
+		in(fmt.Sprintf("resource_tag[%s]", Quote(tag.Key)), tag.Values)
+	}
+	in("permission", f.Permissions)
+	if values := sortedUnique(f.ResourceNameContains); len(values) > 0 {
+		terms = append(terms, Build("resource_name", "contains", values))
+	}
+
+	for _, group := range f.Groups {
+		groupTerms, err := group.terms()
+		if err != nil {
+			return nil, err
+		}
+		if len(groupTerms) == 1 {
+			terms = append(terms, groupTerms[0])
+		} else {
+			terms = append(terms, "("+strings.Join(groupTerms, " "+group.operator()+" ")+")")
+		}
+	}
+
+	if len(terms) == 0 {
+		return nil, errors.New("filter must have at least one condition")
+	}
+
+	return terms, nil
+}
+
+func sortedUnique(values []string) []string {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return slices.Compact(sorted)
+}
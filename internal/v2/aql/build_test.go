@@ -0,0 +1,55 @@
+package aql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterCompile(t *testing.T) {
+	t.Run("Conditions", func(t *testing.T) {
+		query, err := Filter{
+			ResourceNameContains: []string{"prod"},
+			Permissions:          []string{"read"},
+			Tags:                 []Tag{{Key: "env", Values: []string{"staging", "prod", "prod"}}},
+			ResourceTypes:        []string{"aws-rds-mysql-database"},
+			Integrations:         []string{"aws \"prod\""},
+		}.Compile()
+		require.NoError(t, err)
+		assert.Equal(t, `integration = "aws \"prod\"" and resource_type = "aws-rds-mysql-database" and resource_tag["env"] in ("prod", "staging") and permission = "read" and resource_name contains "prod"`, query)
+
+		_, err = Parse(query)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Groups", func(t *testing.T) {
+		query, err := Filter{
+			Operator: "OR",
+			Groups: []Filter{
+				{ResourceTypes: []string{"postgresql-database"}, ResourceNameContains: []string{"prod", "live"}},
+				{Operator: "or", Integrations: []string{"mysql-prod"}},
+				{Operator: "or", ResourceTypes: []string{"a"}, Permissions: []string{"admin"}},
+			},
+		}.Compile()
+		require.NoError(t, err)
+		assert.Equal(t, `(resource_type = "postgresql-database" and (resource_name contains "live" or resource_name contains "prod")) or integration = "mysql-prod" or (resource_type = "a" or permission = "admin")`, query)
+
+		_, err = Parse(query)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := Filter{}.Compile()
+		assert.EqualError(t, err, "filter must have at least one condition")
+
+		_, err = Filter{Groups: []Filter{{ResourceTypes: []string{"a"}}, {}}}.Compile()
+		assert.EqualError(t, err, "filter must have at least one condition")
+
+		_, err = Filter{Operator: "xor", ResourceTypes: []string{"a"}}.Compile()
+		assert.Error(t, err)
+
+		_, err = Filter{Tags: []Tag{{Values: []string{"a"}}}}.Compile()
+		assert.EqualError(t, err, "tag key must not be empty")
+	})
+}
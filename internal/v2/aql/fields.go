@@ -0,0 +1,70 @@
+package aql
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+)
+
+// KnownFields are the AQL fields known to the provider, and whether they take a key, e.g. resource_tag["env"].
+// Fields are added to AQL over time, so unknown fields are reported as warnings rather than errors.
+var KnownFields = map[string]bool{
+	"integration":           false,
+	"integration_type":      false,
+	"permission":            false,
+	"permission_risk_level": false,
+	"resource_id":           false,
+	"resource_name":         false,
+	"resource_risk_level":   false,
+	"resource_tag":          true,
+	"resource_type":         false,
+}
+
+// FieldWarning describes a comparison on a field that isn't known to the provider, or that is used with or without
+// a key contrary to its definition.
+type FieldWarning struct {
+	Comparison *Comparison
+	Message    string
+}
+
+// CheckFields checks the fields of the comparisons of an expression against the known fields.
+func CheckFields(expr Expr) []FieldWarning {
+	var warnings []FieldWarning
+
+	fields := make([]string, 0, len(KnownFields))
+	for field := range KnownFields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, comparison := range Comparisons(expr) {
+		keyed, known := KnownFields[comparison.Field]
+
+		var message string
+		switch {
+		case !known:
+			message = fmt.Sprintf("unknown field %q.%s Known fields are %s.", comparison.Field, common.DidYouMean(comparison.Field, fields), joinFields(fields))
+		case keyed && !comparison.HasKey:
+			message = fmt.Sprintf("field %q requires a key, e.g. %s[\"env\"].", comparison.Field, comparison.Field)
+		case !keyed && comparison.HasKey:
+			message = fmt.Sprintf("field %q does not take a key.", comparison.Field)
+		default:
+			continue
+		}
+
+		warnings = append(warnings, FieldWarning{Comparison: comparison, Message: message})
+	}
+
+	return warnings
+}
+
+func joinFields(fields []string) string {
+	quoted := slices.Clone(fields)
+	for i, field := range quoted {
+		quoted[i] = fmt.Sprintf("%q", field)
+	}
+
+	return fmt.Sprint(quoted)
+}
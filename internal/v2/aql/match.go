@@ -0,0 +1,91 @@
+package aql
+
+import (
+	"slices"
+	"strings"
+)
+
+// Match is the result of evaluating an expression when only some of the fields are known.
+type Match int
+
+const (
+	// NoMatch means the expression is false for every resource.
+	NoMatch Match = iota
+	// MayMatch means the expression depends on fields that aren't known.
+	MayMatch
+	// Matches means the expression is true for every resource.
+	Matches
+)
+
+// Integration holds the fields of an integration that apply to all of its resources.
+type Integration struct {
+	ID   string
+	Name string
+	Type string
+
+	// ResourceTypes are the resource types of the integration, or nil when they aren't known.
+	ResourceTypes []string
+}
+
+// MatchIntegration evaluates an expression for the resources of an integration. The integration field matches the
+// ID or the name of the integration. Comparisons on resource fields other than resource_type, such as tags, can't be
+// evaluated without the resources themselves, so they may match.
+func MatchIntegration(expr Expr, integration Integration) Match {
+	switch e := expr.(type) {
+	case And:
+		return min(MatchIntegration(e.Left, integration), MatchIntegration(e.Right, integration))
+	case Or:
+		return max(MatchIntegration(e.Left, integration), MatchIntegration(e.Right, integration))
+	case Not:
+		return Matches - MatchIntegration(e.Expr, integration)
+	case *Comparison:
+		switch e.Field {
+		case "integration":
+			return matchAll([][]string{{integration.ID, integration.Name}}, e)
+		case "integration_type":
+			return matchAll([][]string{{integration.Type}}, e)
+		case "resource_type":
+			if len(integration.ResourceTypes) == 0 {
+				return MayMatch
+			}
+			var candidates [][]string
+			for _, resourceType := range integration.ResourceTypes {
+				candidates = append(candidates, []string{resourceType})
+			}
+			return matchAll(candidates, e)
+		}
+	}
+
+	return MayMatch
+}
+
+// matchAll evaluates a comparison for groups of alternative values, one group per kind of resource. A group matches
+// when any of its values match.
+func matchAll(groups [][]string, comparison *Comparison) Match {
+	matched := 0
+	for _, group := range groups {
+		if slices.ContainsFunc(group, func(value string) bool { return compare(value, comparison) }) {
+			matched++
+		}
+	}
+
+	negated := strings.HasPrefix(comparison.Operator, "not ") || comparison.Operator == "!="
+	switch {
+	case matched == 0 && !negated, matched == len(groups) && negated:
+		return NoMatch
+	case matched == len(groups) && !negated, matched == 0 && negated:
+		return Matches
+	}
+
+	return MayMatch
+}
+
+// compare reports whether value matches the non-negated form of the operator of the comparison.
+func compare(value string, comparison *Comparison) bool {
+	switch comparison.Operator {
+	case "contains", "not contains":
+		return slices.ContainsFunc(comparison.Values, func(v string) bool { return strings.Contains(value, v) })
+	default:
+		return slices.Contains(comparison.Values, value)
+	}
+}
@@ -0,0 +1,42 @@
+package aql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchIntegration(t *testing.T) {
+	postgres := Integration{ID: "integration-1", Name: "postgres-prod", Type: "postgresql", ResourceTypes: []string{"postgresql-database", "postgresql-table"}}
+	unsynced := Integration{ID: "integration-2", Name: "mysql-prod", Type: "mysql"}
+
+	tests := []struct {
+		query       string
+		integration Integration
+		expected    Match
+	}{
+		{`integration = "postgres-prod"`, postgres, Matches},
+		{`integration = "integration-1"`, postgres, Matches},
+		{`integration = "mysql-prod"`, postgres, NoMatch},
+		{`integration != "postgres-prod"`, postgres, NoMatch},
+		{`integration contains "prod"`, postgres, Matches},
+		{`integration_type not in ("mysql", "mssql")`, postgres, Matches},
+		{`resource_type = "postgresql-database"`, postgres, MayMatch},
+		{`resource_type in ("postgresql-database", "postgresql-table")`, postgres, Matches},
+		{`resource_type = "aws-rds-mysql-database"`, postgres, NoMatch},
+		{`resource_type != "aws-rds-mysql-database"`, postgres, Matches},
+		{`resource_type = "aws-rds-mysql-database"`, unsynced, MayMatch},
+		{`resource_tag["env"] = "prod"`, postgres, MayMatch},
+		{`resource_tag["env"] = "prod" and integration = "mysql-prod"`, postgres, NoMatch},
+		{`resource_tag["env"] = "prod" or integration = "postgres-prod"`, postgres, Matches},
+		{`not integration = "postgres-prod"`, postgres, NoMatch},
+		{`not resource_tag["env"] = "prod"`, postgres, MayMatch},
+	}
+
+	for _, test := range tests {
+		expr, err := Parse(test.query)
+		require.NoError(t, err, test.query)
+		assert.Equal(t, test.expected, MatchIntegration(expr, test.integration), "%s on %s", test.query, test.integration.Name)
+	}
+}
@@ -0,0 +1,480 @@
+// Package aql parses Apono Query Language (AQL) expressions, as used in access scope queries.
+//
+// AQL has no published grammar. The grammar here is derived from the examples of the AQL documentation at
+// https://docs.apono.io/docs/inventory/apono-query-language and from the queries of existing access scopes, so the
+// API may accept syntax that Parse doesn't. Callers should treat a parse error as a hint, and reserve hard errors
+// for the structural failures reported by CheckStructure.
+package aql
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Expr is a parsed AQL expression: a Comparison, or And, Or and Not expressions combining comparisons.
+type Expr interface {
+	expr()
+}
+
+// And is true when both sides are true.
+type And struct {
+	Left, Right Expr
+}
+
+// Or is true when either side is true.
+type Or struct {
+	Left, Right Expr
+}
+
+// Not negates an expression.
+type Not struct {
+	Expr Expr
+}
+
+// Comparison compares a field, optionally with a key such as the tag name of resource_tag["env"], to values.
+type Comparison struct {
+	Field    string
+	Key      string
+	HasKey   bool
+	Operator string
+	Values   []string
+
+	// Pos is the offset of the field in the query.
+	Pos int
+}
+
+func (And) expr()         {}
+func (Or) expr()          {}
+func (Not) expr()         {}
+func (*Comparison) expr() {}
+
+// Operators are the comparison operators of AQL.
+var Operators = []string{"=", "!=", "contains", "not contains", "in", "not in"}
+
+// SyntaxError is a syntax error at a position of a query.
+type SyntaxError struct {
+	Line, Column int
+	Message      string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+// Parse parses an AQL query, e.g. resource_type = "aws-rds-mysql-database" and resource_tag["env"] in ("prod", "staging").
+// Keywords are case-insensitive.
+func Parse(query string) (Expr, error) {
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{query: query, tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, p.errorAt(p.peek(), "query is empty")
+	}
+
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if token := p.peek(); token.kind != tokenEOF {
+		return nil, p.errorAt(token, fmt.Sprintf("unexpected %s, expected and, or or the end of the query", token))
+	}
+
+	return expr, nil
+}
+
+// CheckStructure reports the failures that make a query invalid whatever its grammar: an empty query, an
+// unterminated string, or unbalanced parentheses or brackets. Strings may be quoted with double or single quotes.
+func CheckStructure(query string) error {
+	errorAt := func(offset int, message string) error {
+		line, column := Position(query, offset)
+		return &SyntaxError{Line: line, Column: column, Message: message}
+	}
+
+	if strings.TrimSpace(query) == "" {
+		return errorAt(0, "query is empty")
+	}
+
+	type opening struct {
+		char rune
+		pos  int
+	}
+	closing := map[rune]rune{'(': ')', '[': ']'}
+
+	var open []opening
+	var quote rune
+	var quotePos int
+	escaped := false
+	for pos, r := range query {
+		switch {
+		case quote != 0:
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == quote:
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote, quotePos = r, pos
+		case r == '(' || r == '[':
+			open = append(open, opening{char: r, pos: pos})
+		case r == ')' || r == ']':
+			if len(open) == 0 {
+				return errorAt(pos, fmt.Sprintf("unexpected %q without a matching opening bracket", r))
+			}
+			if last := open[len(open)-1]; closing[last.char] != r {
+				return errorAt(pos, fmt.Sprintf("unexpected %q, expected %q", r, closing[last.char]))
+			}
+			open = open[:len(open)-1]
+		}
+	}
+
+	if quote != 0 {
+		return errorAt(quotePos, "unterminated string")
+	}
+	if len(open) > 0 {
+		last := open[len(open)-1]
+		return errorAt(last.pos, fmt.Sprintf("%q is never closed", last.char))
+	}
+
+	return nil
+}
+
+// Comparisons returns the comparisons of an expression, in the order they appear in the query.
+func Comparisons(expr Expr) []*Comparison {
+	switch e := expr.(type) {
+	case And:
+		return append(Comparisons(e.Left), Comparisons(e.Right)...)
+	case Or:
+		return append(Comparisons(e.Left), Comparisons(e.Right)...)
+	case Not:
+		return Comparisons(e.Expr)
+	case *Comparison:
+		return []*Comparison{e}
+	}
+
+	return nil
+}
+
+// Position returns the line and column, both starting at 1, of an offset in a query.
+func Position(query string, offset int) (int, int) {
+	line, column := 1, 1
+	for _, r := range query[:min(offset, len(query))] {
+		if r == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+
+	return line, column
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenEqual
+	tokenNotEqual
+	tokenLeftParen
+	tokenRightParen
+	tokenLeftBracket
+	tokenRightBracket
+	tokenComma
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of query"
+	case tokenString:
+		return fmt.Sprintf("string %q", t.value)
+	}
+
+	return fmt.Sprintf("%q", t.value)
+}
+
+func (t token) isKeyword(keyword string) bool {
+	return t.kind == tokenIdent && strings.EqualFold(t.value, keyword)
+}
+
+func tokenize(query string) ([]token, error) {
+	var tokens []token
+	runes := []rune(query)
+
+	// Positions are byte offsets, so they can be used to slice the query.
+	offset := func(i int) int { return len(string(runes[:i])) }
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := i
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+			continue
+		case r == '"':
+			var value strings.Builder
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				value.WriteRune(runes[i])
+			}
+			if i == len(runes) {
+				line, column := Position(query, offset(start))
+				return nil, &SyntaxError{Line: line, Column: column, Message: "unterminated string"}
+			}
+			i++
+			tokens = append(tokens, token{kind: tokenString, value: value.String(), pos: offset(start)})
+			continue
+		case r == '_' || unicode.IsLetter(r):
+			for i < len(runes) && (runes[i] == '_' || unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, value: string(runes[start:i]), pos: offset(start)})
+			continue
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			i++
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, value: string(runes[start:i]), pos: offset(start)})
+			continue
+		case r == '!' && i+1 < len(runes) && runes[i+1] == '=':
+			tokens = append(tokens, token{kind: tokenNotEqual, value: "!=", pos: offset(start)})
+			i += 2
+			continue
+		}
+
+		kinds := map[rune]tokenKind{
+			'=': tokenEqual,
+			'(': tokenLeftParen,
+			')': tokenRightParen,
+			'[': tokenLeftBracket,
+			']': tokenRightBracket,
+			',': tokenComma,
+		}
+		kind, ok := kinds[r]
+		if !ok {
+			line, column := Position(query, offset(start))
+			return nil, &SyntaxError{Line: line, Column: column, Message: fmt.Sprintf("unexpected character %q", r)}
+		}
+		tokens = append(tokens, token{kind: kind, value: string(r), pos: offset(start)})
+		i++
+	}
+
+	return append(tokens, token{kind: tokenEOF, pos: len(query)}), nil
+}
+
+type parser struct {
+	query  string
+	tokens []token
+	next   int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *parser) advance() token {
+	token := p.tokens[p.next]
+	if token.kind != tokenEOF {
+		p.next++
+	}
+	return token
+}
+
+func (p *parser) errorAt(t token, message string) error {
+	line, column := Position(p.query, t.pos)
+	return &SyntaxError{Line: line, Column: column, Message: message}
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().isKeyword("or") {
+		p.advance()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = Or{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().isKeyword("and") {
+		p.advance()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = And{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (Expr, error) {
+	if p.peek().isKeyword("not") {
+		p.advance()
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return Not{Expr: expr}, nil
+	}
+
+	if p.peek().kind == tokenLeftParen {
+		p.advance()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if token := p.advance(); token.kind != tokenRightParen {
+			return nil, p.errorAt(token, fmt.Sprintf("unexpected %s, expected )", token))
+		}
+		return expr, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (Expr, error) {
+	field := p.advance()
+	if field.kind != tokenIdent || isKeyword(field.value) {
+		return nil, p.errorAt(field, fmt.Sprintf("unexpected %s, expected a field such as resource_type", field))
+	}
+
+	comparison := &Comparison{Field: strings.ToLower(field.value), Pos: field.pos}
+
+	if p.peek().kind == tokenLeftBracket {
+		p.advance()
+		key := p.advance()
+		if key.kind != tokenString {
+			return nil, p.errorAt(key, fmt.Sprintf("unexpected %s, expected a quoted key such as \"env\"", key))
+		}
+		if token := p.advance(); token.kind != tokenRightBracket {
+			return nil, p.errorAt(token, fmt.Sprintf("unexpected %s, expected ]", token))
+		}
+		comparison.Key, comparison.HasKey = key.value, true
+	}
+
+	operator, err := p.parseOperator()
+	if err != nil {
+		return nil, err
+	}
+	comparison.Operator = operator
+
+	if operator == "in" || operator == "not in" {
+		values, err := p.parseValueList()
+		if err != nil {
+			return nil, err
+		}
+		comparison.Values = values
+		return comparison, nil
+	}
+
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	comparison.Values = []string{value}
+
+	return comparison, nil
+}
+
+func (p *parser) parseOperator() (string, error) {
+	token := p.advance()
+
+	switch {
+	case token.kind == tokenEqual:
+		return "=", nil
+	case token.kind == tokenNotEqual:
+		return "!=", nil
+	case token.isKeyword("contains"):
+		return "contains", nil
+	case token.isKeyword("in"):
+		return "in", nil
+	case token.isKeyword("not"):
+		next := p.advance()
+		switch {
+		case next.isKeyword("contains"):
+			return "not contains", nil
+		case next.isKeyword("in"):
+			return "not in", nil
+		}
+		return "", p.errorAt(next, fmt.Sprintf("unexpected %s, expected contains or in", next))
+	}
+
+	return "", p.errorAt(token, fmt.Sprintf("unexpected %s, expected an operator (%s)", token, strings.Join(Operators, ", ")))
+}
+
+func (p *parser) parseValueList() ([]string, error) {
+	if token := p.advance(); token.kind != tokenLeftParen {
+		return nil, p.errorAt(token, fmt.Sprintf("unexpected %s, expected ( followed by a list of values", token))
+	}
+
+	var values []string
+	for {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		token := p.advance()
+		if token.kind == tokenRightParen {
+			return values, nil
+		}
+		if token.kind != tokenComma {
+			return nil, p.errorAt(token, fmt.Sprintf("unexpected %s, expected , or )", token))
+		}
+	}
+}
+
+func (p *parser) parseValue() (string, error) {
+	token := p.advance()
+	if token.kind != tokenString && token.kind != tokenNumber {
+		return "", p.errorAt(token, fmt.Sprintf("unexpected %s, expected a quoted value", token))
+	}
+
+	return token.value, nil
+}
+
+func isKeyword(value string) bool {
+	switch strings.ToLower(value) {
+	case "and", "or", "not", "in", "contains":
+		return true
+	}
+
+	return false
+}
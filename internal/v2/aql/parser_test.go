@@ -0,0 +1,115 @@
+package aql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		queries := []string{
+			`resource_type = "mock-duck"`,
+			`integration = "5161d0f2-242d-42ee-92cb-8afd30caa0" and resource_type = "mock-duck"`,
+			"resource_type = \"aws-account-ec2-instance\"\n  and resource_tag[\"env\"] = \"production\"\n  and resource_risk_level = \"1\"",
+			`resource_type = "aws-rds-mysql-database" and resource_name contains "prod" and (resource_tag["region"] = "us-east-1") and permission_risk_level = "3"`,
+			`resource_type in ("a", "b") and (resource_name not contains "test" and resource_name not contains "sandbox")`,
+			`NOT (resource_type != "a" OR resource_tag["team"] NOT IN ("x"))`,
+			`resource_name = "escaped \"quote\" and \\ backslash"`,
+			`resource_risk_level = 3`,
+		}
+
+		for _, query := range queries {
+			_, err := Parse(query)
+			assert.NoError(t, err, query)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		tests := map[string]string{
+			``:                           "line 1, column 1: query is empty",
+			`resource_type = `:           "line 1, column 17: unexpected end of query, expected a quoted value",
+			`resource_type == "a"`:       `line 1, column 16: unexpected "=", expected a quoted value`,
+			`resource_type = "a`:         "line 1, column 17: unterminated string",
+			`resource_type is "a"`:       `line 1, column 15: unexpected "is", expected an operator (=, !=, contains, not contains, in, not in)`,
+			`resource_type = "a" and`:    "line 1, column 24: unexpected end of query, expected a field such as resource_type",
+			`(resource_type = "a"`:       "line 1, column 21: unexpected end of query, expected )",
+			`resource_type in "a"`:       `line 1, column 18: unexpected string "a", expected ( followed by a list of values`,
+			`resource_type in ("a" "b")`: `line 1, column 23: unexpected string "b", expected , or )`,
+			`resource_type = "a" resource_name = "b"`:                `line 1, column 21: unexpected "resource_name", expected and, or or the end of the query`,
+			"resource_type = \"a\"\n  and resource_tag[env] = \"b\"": `line 2, column 20: unexpected "env", expected a quoted key such as "env"`,
+			`resource_type = "a" & resource_name = "b"`:              `line 1, column 21: unexpected character '&'`,
+			`resource_type not = "a"`:                                `line 1, column 19: unexpected "=", expected contains or in`,
+		}
+
+		for query, expected := range tests {
+			_, err := Parse(query)
+			require.Error(t, err, query)
+			assert.Equal(t, expected, err.Error(), query)
+		}
+	})
+
+	t.Run("Expression", func(t *testing.T) {
+		expr, err := Parse(`resource_type = "a" or not resource_tag["env"] in ("prod", "staging") and integration != "x"`)
+		require.NoError(t, err)
+
+		assert.Equal(t, Or{
+			Left: &Comparison{Field: "resource_type", Operator: "=", Values: []string{"a"}, Pos: 0},
+			Right: And{
+				Left:  Not{Expr: &Comparison{Field: "resource_tag", Key: "env", HasKey: true, Operator: "in", Values: []string{"prod", "staging"}, Pos: 27}},
+				Right: &Comparison{Field: "integration", Operator: "!=", Values: []string{"x"}, Pos: 74},
+			},
+		}, expr)
+	})
+}
+
+func TestCheckStructure(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		queries := []string{
+			`resource_type = "a" and (resource_tag["env"] in ("prod", "staging"))`,
+			`resource_name starts with "prod"`,
+			`resource_name = 'prod'`,
+			`resource_name = "(" and resource_name = ')]'`,
+			`resource_name = "escaped \" quote"`,
+		}
+
+		for _, query := range queries {
+			assert.NoError(t, CheckStructure(query), query)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		tests := map[string]string{
+			``:                                "line 1, column 1: query is empty",
+			" \n ":                            "line 1, column 1: query is empty",
+			`resource_type = "a`:              "line 1, column 17: unterminated string",
+			`resource_type = 'a`:              "line 1, column 17: unterminated string",
+			`(resource_type = "a"`:            `line 1, column 1: '(' is never closed`,
+			`resource_type = "a")`:            `line 1, column 20: unexpected ')' without a matching opening bracket`,
+			`resource_tag["env") = "a"`:       `line 1, column 19: unexpected ')', expected ']'`,
+			"(resource_type = \"a\"\n  or (x": `line 2, column 6: '(' is never closed`,
+		}
+
+		for query, expected := range tests {
+			err := CheckStructure(query)
+			require.Error(t, err, query)
+			assert.Equal(t, expected, err.Error(), query)
+		}
+	})
+}
+
+func TestCheckFields(t *testing.T) {
+	expr, err := Parse(`resource_typ = "a" and resource_tag = "b" and resource_name["x"] = "c" and resource_tag["env"] = "prod"`)
+	require.NoError(t, err)
+
+	var messages []string
+	for _, warning := range CheckFields(expr) {
+		messages = append(messages, warning.Message)
+	}
+
+	require.Len(t, messages, 3)
+	assert.Contains(t, messages[0], `unknown field "resource_typ". Did you mean "resource_type"?`)
+	assert.Equal(t, `field "resource_tag" requires a key, e.g. resource_tag["env"].`, messages[1])
+	assert.Equal(t, `field "resource_name" does not take a key.`, messages[2])
+}
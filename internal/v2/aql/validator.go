@@ -0,0 +1,49 @@
+package aql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// QueryValidator validates that a string attribute is a structurally valid AQL query. Queries that the provider's
+// grammar doesn't recognize, and fields that aren't known to the provider, are reported as warnings, since the API
+// may accept syntax and fields the provider doesn't know about yet.
+func QueryValidator() validator.String {
+	return queryValidator{}
+}
+
+type queryValidator struct{}
+
+func (v queryValidator) Description(_ context.Context) string {
+	return "value must be a structurally valid Apono Query Language (AQL) query"
+}
+
+func (v queryValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v queryValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	query := req.ConfigValue.ValueString()
+	if err := CheckStructure(query); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid AQL Query", fmt.Sprintf("The query is not valid Apono Query Language: %s.", err))
+		return
+	}
+
+	expr, err := Parse(query)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(req.Path, "Unrecognized AQL Query", fmt.Sprintf(
+			"The provider could not parse the query: %s. The query is sent to Apono as is, which may accept syntax the provider doesn't know about.", err))
+		return
+	}
+
+	for _, warning := range CheckFields(expr) {
+		line, column := Position(query, warning.Comparison.Pos)
+		resp.Diagnostics.AddAttributeWarning(req.Path, "Unknown AQL Field", fmt.Sprintf("Line %d, column %d: %s", line, column, warning.Message))
+	}
+}
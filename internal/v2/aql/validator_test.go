@@ -0,0 +1,43 @@
+package aql
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryValidator(t *testing.T) {
+	validate := func(value types.String) *validator.StringResponse {
+		resp := &validator.StringResponse{}
+		QueryValidator().ValidateString(t.Context(), validator.StringRequest{Path: path.Root("query"), ConfigValue: value}, resp)
+		return resp
+	}
+
+	resp := validate(types.StringValue(`resource_type = "mock-duck" and resource_tag["env"] = "prod"`))
+	assert.Empty(t, resp.Diagnostics)
+
+	resp = validate(types.StringValue(`resource_type = "mock-duck" and (resource_name = "db"`))
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "line 1, column 33: '(' is never closed")
+
+	// Syntax the provider's grammar doesn't recognize may still be valid AQL, so it's only a warning.
+	for _, query := range []string{`resource_type = "mock-duck" and`, `resource_name starts with "prod"`, `resource_name = 'prod'`} {
+		resp = validate(types.StringValue(query))
+		require.False(t, resp.Diagnostics.HasError(), query)
+		require.Len(t, resp.Diagnostics.Warnings(), 1, query)
+		assert.Equal(t, "Unrecognized AQL Query", resp.Diagnostics.Warnings()[0].Summary(), query)
+	}
+	assert.Contains(t, validate(types.StringValue(`resource_type = "mock-duck" and`)).Diagnostics.Warnings()[0].Detail(), "line 1, column 32")
+
+	resp = validate(types.StringValue("resource_type = \"mock-duck\"\n  and resource_nme = \"db\""))
+	require.False(t, resp.Diagnostics.HasError())
+	require.Len(t, resp.Diagnostics.Warnings(), 1)
+	assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), `Line 2, column 7: unknown field "resource_nme". Did you mean "resource_name"?`)
+
+	assert.Empty(t, validate(types.StringUnknown()).Diagnostics)
+	assert.Empty(t, validate(types.StringNull()).Diagnostics)
+}
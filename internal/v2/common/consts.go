@@ -4,3 +4,7 @@ const ResourceCategory = "RESOURCES"
 const UserInformationCategory = "USER-INFORMATION"
 const DefaultMatchOperator = "is"
 const MockDuck = "mock-duck"
+
+var MatchOperators = []string{"is", "is_not", "contains", "does_not_contain", "starts_with"}
+
+var DaysOfWeek = []string{"MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"}
@@ -0,0 +1,41 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DynamicObjectValidator validates that a dynamic attribute is an object or a map, e.g. { host = "localhost" }.
+func DynamicObjectValidator() validator.Dynamic {
+	return dynamicObjectValidator{}
+}
+
+type dynamicObjectValidator struct{}
+
+func (v dynamicObjectValidator) Description(_ context.Context) string {
+	return "value must be an object"
+}
+
+func (v dynamicObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v dynamicObjectValidator) ValidateDynamic(ctx context.Context, req validator.DynamicRequest, resp *validator.DynamicResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.IsUnderlyingValueNull() || req.ConfigValue.IsUnderlyingValueUnknown() {
+		return
+	}
+
+	switch req.ConfigValue.UnderlyingValue().(type) {
+	case types.Object, types.Map:
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("The value must be an object, e.g. { host = \"localhost\" }, got %s.", req.ConfigValue.UnderlyingValue().Type(ctx)),
+	)
+}
@@ -0,0 +1,55 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ValidateEmail checks that value is a bare email address, e.g. john@example.com, without a display name or
+// surrounding whitespace, and with a fully qualified domain.
+func ValidateEmail(value string) error {
+	address, err := mail.ParseAddress(value)
+	if err != nil || address.Name != "" || address.Address != value {
+		return fmt.Errorf("%q is not a valid email address, e.g. john@example.com", value)
+	}
+
+	if _, domain, _ := strings.Cut(value, "@"); !strings.Contains(domain, ".") {
+		return fmt.Errorf("%q is not a valid email address, its domain %q is not fully qualified", value, domain)
+	}
+
+	return nil
+}
+
+// NormalizeEmail returns the form of an email used to compare emails, which are case-insensitive in Apono.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// EmailValidator validates that a string attribute is a bare email address.
+func EmailValidator() validator.String {
+	return emailValidator{}
+}
+
+type emailValidator struct{}
+
+func (v emailValidator) Description(_ context.Context) string {
+	return "value must be an email address"
+}
+
+func (v emailValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v emailValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := ValidateEmail(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Email", err.Error())
+	}
+}
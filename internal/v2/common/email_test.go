@@ -0,0 +1,21 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateEmail(t *testing.T) {
+	for _, value := range []string{"john@example.com", "John.Doe@Example.com", "john+ops@mail.example.co.uk"} {
+		assert.NoError(t, ValidateEmail(value), value)
+	}
+
+	for _, value := range []string{"", "john", "john@", "@example.com", "john@localhost", " john@example.com", "John <john@example.com>", "john@example.com,jane@example.com"} {
+		assert.Error(t, ValidateEmail(value), value)
+	}
+}
+
+func TestNormalizeEmail(t *testing.T) {
+	assert.Equal(t, "john.doe@example.com", NormalizeEmail(" John.Doe@Example.com"))
+}
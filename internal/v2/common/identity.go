@@ -0,0 +1,55 @@
+package common
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ResourceIdentityModel is the identity shared by all Apono resources. Names are unique per resource type,
+// so they are part of the identity, but only the ID is required to import a resource.
+type ResourceIdentityModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func GetResourceIdentitySchema(resourceType string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the " + resourceType + ".",
+				RequiredForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "The name of the " + resourceType + ".",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+// SetResourceIdentity sets the identity of a resource after it was created, read or updated.
+// The identity is nil when the resource has no identity schema or Terraform doesn't support identities.
+func SetResourceIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id, name types.String) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, ResourceIdentityModel{ID: id, Name: name})
+}
+
+// GetImportID returns the import ID, or the ID from the resource identity when importing by identity.
+func GetImportID(ctx context.Context, req resource.ImportStateRequest) (string, diag.Diagnostics) {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID, nil
+	}
+
+	var id types.String
+	diags := req.Identity.GetAttribute(ctx, path.Root("id"), &id)
+	return id.ValueString(), diags
+}
@@ -1,6 +1,19 @@
 package common
 
-import "github.com/go-faster/jx"
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/go-faster/jx"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
 
 // StringToJx converts a string to a jx.Raw JSON representation.
 func StringToJx(s string) jx.Raw {
@@ -13,3 +26,227 @@ func StringToJx(s string) jx.Raw {
 func JxToString(r jx.Raw) (string, error) {
 	return jx.DecodeStr(r.String()).Str()
 }
+
+// JxToText converts a jx.Raw JSON representation to text. Strings are decoded, and other values are returned as
+// compact JSON, e.g. [{"host":"10.0.5.42"}].
+func JxToText(r jx.Raw) (string, error) {
+	if str, err := JxToString(r); err == nil {
+		return str, nil
+	}
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, r); err != nil {
+		return "", err
+	}
+
+	return compact.String(), nil
+}
+
+// JxToValue converts a jx.Raw JSON representation to a Terraform value. Strings, numbers and booleans are converted
+// to the matching primitive values, arrays to tuples and objects to objects, as Terraform does for the equivalent
+// HCL expressions. Nulls are converted to null strings.
+func JxToValue(ctx context.Context, r jx.Raw) (attr.Value, error) {
+	decoder := json.NewDecoder(bytes.NewReader(r))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return jsonToValue(ctx, value)
+}
+
+func jsonToValue(ctx context.Context, value any) (attr.Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case string:
+		return types.StringValue(v), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case json.Number:
+		number, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s: %w", v, err)
+		}
+		return types.NumberValue(number), nil
+	case []any:
+		elementTypes := make([]attr.Type, 0, len(v))
+		elements := make([]attr.Value, 0, len(v))
+		for _, item := range v {
+			element, err := jsonToValue(ctx, item)
+			if err != nil {
+				return nil, err
+			}
+			elementTypes = append(elementTypes, element.Type(ctx))
+			elements = append(elements, element)
+		}
+
+		tuple, diags := types.TupleValue(elementTypes, elements)
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to convert array: %v", diags)
+		}
+		return tuple, nil
+	case map[string]any:
+		attributeTypes := make(map[string]attr.Type, len(v))
+		attributes := make(map[string]attr.Value, len(v))
+		for key, item := range v {
+			attribute, err := jsonToValue(ctx, item)
+			if err != nil {
+				return nil, err
+			}
+			attributeTypes[key] = attribute.Type(ctx)
+			attributes[key] = attribute
+		}
+
+		object, diags := types.ObjectValue(attributeTypes, attributes)
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to convert object: %v", diags)
+		}
+		return object, nil
+	}
+
+	return nil, fmt.Errorf("unsupported JSON value %v", value)
+}
+
+// ValueToJx converts a Terraform value to a jx.Raw JSON representation. Values of dynamic attributes are converted
+// by their underlying value, and lists, sets and tuples are converted to arrays and maps and objects to objects.
+func ValueToJx(value attr.Value) (jx.Raw, error) {
+	converted, err := valueToJSON(value)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := json.Marshal(converted)
+	if err != nil {
+		return nil, err
+	}
+
+	return raw, nil
+}
+
+func valueToJSON(value attr.Value) (any, error) {
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("value is unknown")
+	}
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	switch v := value.(type) {
+	case basetypes.DynamicValue:
+		if v.IsUnderlyingValueUnknown() {
+			return nil, fmt.Errorf("value is unknown")
+		}
+		return valueToJSON(v.UnderlyingValue())
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+	case basetypes.BoolValue:
+		return v.ValueBool(), nil
+	case basetypes.Int64Value:
+		return v.ValueInt64(), nil
+	case basetypes.Float64Value:
+		return v.ValueFloat64(), nil
+	case basetypes.NumberValue:
+		return json.Number(v.ValueBigFloat().Text('g', -1)), nil
+	case basetypes.ObjectValue:
+		return attributesToJSON(v.Attributes())
+	case basetypes.MapValue:
+		return attributesToJSON(v.Elements())
+	case basetypes.ListValue:
+		return elementsToJSON(v.Elements())
+	case basetypes.SetValue:
+		return elementsToJSON(v.Elements())
+	case basetypes.TupleValue:
+		return elementsToJSON(v.Elements())
+	}
+
+	return nil, fmt.Errorf("unsupported value type %T", value)
+}
+
+func attributesToJSON(attributes map[string]attr.Value) (map[string]any, error) {
+	result := make(map[string]any, len(attributes))
+	for key, attribute := range attributes {
+		converted, err := valueToJSON(attribute)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		result[key] = converted
+	}
+
+	return result, nil
+}
+
+func elementsToJSON(elements []attr.Value) ([]any, error) {
+	result := make([]any, 0, len(elements))
+	for i, element := range elements {
+		converted, err := valueToJSON(element)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %w", i, err)
+		}
+		result = append(result, converted)
+	}
+
+	return result, nil
+}
+
+// JSONSemanticEqual reports whether two JSON representations hold the same value, regardless of formatting and of
+// the order of object keys. A string that contains a JSON array or object is compared as the decoded array or object,
+// and numbers and booleans are compared with their string form, e.g. "[\"a\"]" equals ["a"] and "5432" equals 5432.
+func JSONSemanticEqual(a, b jx.Raw) bool {
+	normalizedA, err := normalizeJSON(a)
+	if err != nil {
+		return false
+	}
+
+	normalizedB, err := normalizeJSON(b)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(normalizedA, normalizedB)
+}
+
+func normalizeJSON(r []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(r))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return normalizeJSONValue(value), nil
+}
+
+func normalizeJSONValue(value any) any {
+	switch v := value.(type) {
+	case string:
+		if trimmed := strings.TrimSpace(v); strings.HasPrefix(trimmed, "[") || strings.HasPrefix(trimmed, "{") {
+			if decoded, err := normalizeJSON([]byte(trimmed)); err == nil {
+				return decoded
+			}
+		}
+		return v
+	case json.Number:
+		if number, _, err := big.ParseFloat(v.String(), 10, 512, big.ToNearestEven); err == nil {
+			return number.Text('g', -1)
+		}
+		return v.String()
+	case bool:
+		return fmt.Sprint(v)
+	case []any:
+		for i, item := range v {
+			v[i] = normalizeJSONValue(item)
+		}
+		return v
+	case map[string]any:
+		for key, item := range v {
+			v[key] = normalizeJSONValue(item)
+		}
+		return v
+	}
+
+	return value
+}
@@ -1,9 +1,12 @@
 package common
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/go-faster/jx"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -52,3 +55,88 @@ func TestRoundTrip_StringWithSpecialChars(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, original, result)
 }
+
+func TestJxToText(t *testing.T) {
+	text, err := JxToText(jx.Raw(`"localhost"`))
+	require.NoError(t, err)
+	assert.Equal(t, "localhost", text)
+
+	text, err = JxToText(jx.Raw(`[ { "host": "10.0.5.42" } ]`))
+	require.NoError(t, err)
+	assert.Equal(t, `[{"host":"10.0.5.42"}]`, text)
+
+	text, err = JxToText(jx.Raw(`2222`))
+	require.NoError(t, err)
+	assert.Equal(t, "2222", text)
+}
+
+func TestJxToValue(t *testing.T) {
+	ctx := t.Context()
+
+	value, err := JxToValue(ctx, jx.Raw(`{"servers": [{"host": "10.0.5.42", "port": 2222}], "enabled": true, "shell": null}`))
+	require.NoError(t, err)
+
+	server := types.ObjectValueMust(
+		map[string]attr.Type{"host": types.StringType, "port": types.NumberType},
+		map[string]attr.Value{"host": types.StringValue("10.0.5.42"), "port": types.NumberValue(big.NewFloat(2222))},
+	)
+	expected := types.ObjectValueMust(
+		map[string]attr.Type{
+			"servers": types.TupleType{ElemTypes: []attr.Type{server.Type(ctx)}},
+			"enabled": types.BoolType,
+			"shell":   types.StringType,
+		},
+		map[string]attr.Value{
+			"servers": types.TupleValueMust([]attr.Type{server.Type(ctx)}, []attr.Value{server}),
+			"enabled": types.BoolValue(true),
+			"shell":   types.StringNull(),
+		},
+	)
+	assert.True(t, expected.Equal(value), "got %s", value)
+}
+
+func TestValueToJx(t *testing.T) {
+	value := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{
+			"servers": types.ListType{ElemType: types.StringType},
+			"port":    types.NumberType,
+			"name":    types.StringType,
+		},
+		map[string]attr.Value{
+			"servers": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+			"port":    types.NumberValue(big.NewFloat(2222)),
+			"name":    types.StringNull(),
+		},
+	))
+
+	raw, err := ValueToJx(value)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"servers": ["a", "b"], "port": 2222, "name": null}`, string(raw))
+
+	_, err = ValueToJx(types.DynamicUnknown())
+	assert.Error(t, err)
+}
+
+func TestJSONSemanticEqual(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  string
+		equal bool
+	}{
+		{name: "formatting", a: `{"a": [1, 2]}`, b: `{ "a" : [ 1,2 ] }`, equal: true},
+		{name: "key order", a: `{"a": 1, "b": 2}`, b: `{"b": 2, "a": 1}`, equal: true},
+		{name: "string-encoded array", a: `"[{\"host\": \"10.0.5.42\"}]"`, b: `[{"host":"10.0.5.42"}]`, equal: true},
+		{name: "reformatted string-encoded array", a: `"[{ \"host\": \"10.0.5.42\" }]"`, b: `"[{\"host\":\"10.0.5.42\"}]"`, equal: true},
+		{name: "stringified number", a: `"5432"`, b: `5432`, equal: true},
+		{name: "stringified bool", a: `"true"`, b: `true`, equal: true},
+		{name: "different values", a: `["a"]`, b: `["b"]`, equal: false},
+		{name: "array order", a: `["a", "b"]`, b: `["b", "a"]`, equal: false},
+		{name: "string that is not JSON", a: `"[not json"`, b: `"[not json"`, equal: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.equal, JSONSemanticEqual(jx.Raw(tt.a), jx.Raw(tt.b)))
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// SourceProviderAddress is the registry address suffix of this provider, used to accept moves between its resources.
+const SourceProviderAddress = "apono-io/apono"
+
+// GetMovedResourceID returns the ID of the source resource of a `moved` block when it is of the given type
+// and was managed by this provider. The v1 and v2 resources share the same IDs, so the ID is enough
+// to rebuild the target state from the API.
+// It returns false when the move is not handled, either because the source doesn't match or because an error was added to resp.
+func GetMovedResourceID(req resource.MoveStateRequest, resp *resource.MoveStateResponse, sourceTypeName string) (string, bool) {
+	if req.SourceTypeName != sourceTypeName || !strings.HasSuffix(req.SourceProviderAddress, SourceProviderAddress) {
+		return "", false
+	}
+
+	if req.SourceRawState == nil {
+		resp.Diagnostics.AddError(
+			"Unable to move resource state",
+			fmt.Sprintf("The prior state of %s is missing.", sourceTypeName),
+		)
+		return "", false
+	}
+
+	var source struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to move resource state",
+			fmt.Sprintf("Unable to parse the prior state of %s, got error: %s", sourceTypeName, err),
+		)
+		return "", false
+	}
+
+	if source.ID == "" {
+		resp.Diagnostics.AddError(
+			"Unable to move resource state",
+			fmt.Sprintf("The prior state of %s has no id.", sourceTypeName),
+		)
+		return "", false
+	}
+
+	return source.ID, true
+}
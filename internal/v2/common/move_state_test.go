@@ -0,0 +1,85 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMovedResourceID(t *testing.T) {
+	tests := []struct {
+		name           string
+		providerAddr   string
+		sourceTypeName string
+		rawState       *tfprotov6.RawState
+		expectedID     string
+		expectedOK     bool
+		expectedError  string
+	}{
+		{
+			name:           "MatchingSource",
+			providerAddr:   "registry.terraform.io/apono-io/apono",
+			sourceTypeName: "apono_integration",
+			rawState:       &tfprotov6.RawState{JSON: []byte(`{"id":"integration-123","name":"postgresql"}`)},
+			expectedID:     "integration-123",
+			expectedOK:     true,
+		},
+		{
+			name:           "OtherSourceType",
+			providerAddr:   "registry.terraform.io/apono-io/apono",
+			sourceTypeName: "apono_access_bundle",
+			rawState:       &tfprotov6.RawState{JSON: []byte(`{"id":"123"}`)},
+		},
+		{
+			name:           "OtherProvider",
+			providerAddr:   "registry.terraform.io/hashicorp/null",
+			sourceTypeName: "apono_integration",
+			rawState:       &tfprotov6.RawState{JSON: []byte(`{"id":"123"}`)},
+		},
+		{
+			name:           "MissingState",
+			providerAddr:   "registry.terraform.io/apono-io/apono",
+			sourceTypeName: "apono_integration",
+			expectedError:  "The prior state of apono_integration is missing.",
+		},
+		{
+			name:           "InvalidState",
+			providerAddr:   "registry.terraform.io/apono-io/apono",
+			sourceTypeName: "apono_integration",
+			rawState:       &tfprotov6.RawState{JSON: []byte(`{"id":`)},
+			expectedError:  "Unable to parse the prior state of apono_integration",
+		},
+		{
+			name:           "MissingID",
+			providerAddr:   "registry.terraform.io/apono-io/apono",
+			sourceTypeName: "apono_integration",
+			rawState:       &tfprotov6.RawState{JSON: []byte(`{"name":"postgresql"}`)},
+			expectedError:  "The prior state of apono_integration has no id.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.MoveStateRequest{
+				SourceProviderAddress: tt.providerAddr,
+				SourceTypeName:        tt.sourceTypeName,
+				SourceRawState:        tt.rawState,
+			}
+			resp := resource.MoveStateResponse{}
+
+			id, ok := GetMovedResourceID(req, &resp, "apono_integration")
+
+			assert.Equal(t, tt.expectedID, id)
+			assert.Equal(t, tt.expectedOK, ok)
+			if tt.expectedError == "" {
+				assert.False(t, resp.Diagnostics.HasError())
+				return
+			}
+			if assert.True(t, resp.Diagnostics.HasError()) {
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tt.expectedError)
+			}
+		})
+	}
+}
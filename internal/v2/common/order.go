@@ -0,0 +1,57 @@
+package common
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PreserveOrder returns current reordered to follow the order of prior when both hold the same elements, compared by
+// key, so that a list returned by the API in a different order doesn't show up as a diff. Otherwise current is returned
+// unchanged. Each matched element is passed to reconcile, if set, together with its prior counterpart, so that the
+// order of nested lists can be preserved as well.
+func PreserveOrder[T any](prior, current []T, key func(T) string, reconcile func(prior, current T) T) []T {
+	if len(prior) != len(current) || len(current) == 0 {
+		return current
+	}
+
+	remaining := make(map[string][]T, len(current))
+	for _, element := range current {
+		elementKey := key(element)
+		remaining[elementKey] = append(remaining[elementKey], element)
+	}
+
+	ordered := make([]T, 0, len(current))
+	for _, priorElement := range prior {
+		elementKey := key(priorElement)
+		matches := remaining[elementKey]
+		if len(matches) == 0 {
+			return current
+		}
+
+		element := matches[0]
+		remaining[elementKey] = matches[1:]
+		if reconcile != nil {
+			element = reconcile(priorElement, element)
+		}
+		ordered = append(ordered, element)
+	}
+
+	return ordered
+}
+
+// PreserveListOrder preserves the order of a list of primitive values, see PreserveOrder.
+func PreserveListOrder(ctx context.Context, prior, current types.List) types.List {
+	if prior.IsNull() || prior.IsUnknown() || current.IsNull() || current.IsUnknown() {
+		return current
+	}
+
+	elements := PreserveOrder(prior.Elements(), current.Elements(), attr.Value.String, nil)
+	ordered, diags := types.ListValue(current.ElementType(ctx), elements)
+	if diags.HasError() {
+		return current
+	}
+
+	return ordered
+}
@@ -0,0 +1,46 @@
+package common
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func stringList(values ...string) types.List {
+	elements := make([]attr.Value, len(values))
+	for i, value := range values {
+		elements[i] = types.StringValue(value)
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func TestPreserveOrder(t *testing.T) {
+	identity := func(value string) string { return value }
+
+	t.Run("Reordered", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "b", "a", "c"}, PreserveOrder([]string{"a", "b", "a", "c"}, []string{"c", "a", "a", "b"}, identity, nil))
+	})
+
+	t.Run("DifferentElements", func(t *testing.T) {
+		assert.Equal(t, []string{"c", "a"}, PreserveOrder([]string{"a", "b"}, []string{"c", "a"}, identity, nil))
+		assert.Equal(t, []string{"a", "a"}, PreserveOrder([]string{"a", "b"}, []string{"a", "a"}, identity, nil))
+		assert.Equal(t, []string{"a"}, PreserveOrder([]string{"a", "b"}, []string{"a"}, identity, nil))
+	})
+
+	t.Run("Reconcile", func(t *testing.T) {
+		key := func(value int) string { return strconv.Itoa(value % 10) }
+		reconcile := func(prior, current int) int { return prior*100 + current }
+		assert.Equal(t, []int{1121, 2212}, PreserveOrder([]int{11, 22}, []int{21, 12}, key, reconcile))
+	})
+}
+
+func TestPreserveListOrder(t *testing.T) {
+	ctx := t.Context()
+
+	assert.Equal(t, stringList("b", "a"), PreserveListOrder(ctx, stringList("b", "a"), stringList("a", "b")))
+	assert.Equal(t, stringList("a", "c"), PreserveListOrder(ctx, stringList("b", "a"), stringList("a", "c")))
+	assert.Equal(t, stringList("a", "b"), PreserveListOrder(ctx, types.ListNull(types.StringType), stringList("a", "b")))
+}
@@ -0,0 +1,49 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ProviderSettings holds provider-level configuration shared by resources.
+type ProviderSettings struct {
+	// DefaultLabels are merged into the labels of every access flow managed by the provider.
+	DefaultLabels []string
+
+	// ReadOnly prevents resources from creating, updating or deleting anything in the tenant.
+	ReadOnly bool
+}
+
+// SettingsProvider is an interface for accessing provider-level settings.
+// This avoids cyclic dependencies between packages.
+type SettingsProvider interface {
+	Settings() ProviderSettings
+}
+
+// ConfigureResourceSettings sets up the ProviderSettings from the provider data for resources.
+func ConfigureResourceSettings(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse, target *ProviderSettings) {
+	settingsProvider, ok := req.ProviderData.(SettingsProvider)
+	if !ok {
+		return
+	}
+
+	*target = settingsProvider.Settings()
+}
+
+// CheckWriteAllowed returns an error diagnostic when the provider is configured as read-only.
+// The action describes the rejected operation, e.g. "create access flow".
+func (s ProviderSettings) CheckWriteAllowed(action string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if s.ReadOnly {
+		diags.AddError(
+			"Provider is read-only",
+			fmt.Sprintf("Unable to %s: the provider is configured with read_only = true, which prevents any changes to the Apono tenant. "+
+				"Unset read_only (or the APONO_READ_ONLY environment variable) to apply changes.", action),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,59 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ClosestMatch returns the candidate closest to value by case-insensitive edit distance, provided that it is close
+// enough to be a likely typo of value.
+func ClosestMatch(value string, candidates []string) (string, bool) {
+	normalized := strings.ToLower(value)
+	maxDistance := max(2, len([]rune(normalized))/3)
+
+	best, bestDistance := "", maxDistance+1
+	for _, candidate := range candidates {
+		distance := editDistance(normalized, strings.ToLower(candidate))
+		if distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+
+	return best, best != ""
+}
+
+// DidYouMean returns a " Did you mean ...?" sentence suggesting the candidate closest to value, or an empty string
+// when no candidate is close enough.
+func DidYouMean(value string, candidates []string) string {
+	match, ok := ClosestMatch(value, candidates)
+	if !ok {
+		return ""
+	}
+
+	return fmt.Sprintf(" Did you mean %q?", match)
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	source, target := []rune(a), []rune(b)
+
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+		for j := 1; j <= len(target); j++ {
+			substitution := previous[j-1]
+			if source[i-1] != target[j-1] {
+				substitution++
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, substitution)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(target)]
+}
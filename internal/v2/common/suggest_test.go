@@ -0,0 +1,37 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClosestMatch(t *testing.T) {
+	candidates := []string{"postgresql-prod", "postgresql-dev", "mysql-prod"}
+
+	match, ok := ClosestMatch("postgressql-prod", candidates)
+	assert.True(t, ok)
+	assert.Equal(t, "postgresql-prod", match)
+
+	match, ok = ClosestMatch("MySQL-Prod", candidates)
+	assert.True(t, ok)
+	assert.Equal(t, "mysql-prod", match)
+
+	_, ok = ClosestMatch("kubernetes", candidates)
+	assert.False(t, ok)
+
+	_, ok = ClosestMatch("anything", nil)
+	assert.False(t, ok)
+}
+
+func TestDidYouMean(t *testing.T) {
+	assert.Equal(t, ` Did you mean "QA ENV"?`, DidYouMean("QA EVN", []string{"QA ENV", "PROD ENV"}))
+	assert.Empty(t, DidYouMean("staging", []string{"QA ENV", "PROD ENV"}))
+}
+
+func TestEditDistance(t *testing.T) {
+	assert.Equal(t, 0, editDistance("abc", "abc"))
+	assert.Equal(t, 3, editDistance("", "abc"))
+	assert.Equal(t, 1, editDistance("abc", "abd"))
+	assert.Equal(t, 3, editDistance("kitten", "sitting"))
+}
@@ -0,0 +1,101 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+	_ "time/tzdata" // time zones are validated on hosts without a time zone database too
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// TimeOfDayLayout is the 24-hour HH:MM format of the start and end times of access flow timeframes.
+const TimeOfDayLayout = "15:04"
+
+const timeOfDayWithSecondsLayout = "15:04:05"
+
+// ParseTimeOfDay parses a time of day in the 24-hour HH:MM format.
+func ParseTimeOfDay(value string) (time.Time, error) {
+	parsed, err := time.Parse(TimeOfDayLayout, value)
+	if err != nil || len(value) != len(TimeOfDayLayout) {
+		return time.Time{}, fmt.Errorf("%q is not a time in the 24-hour HH:MM format, e.g. 08:00 or 17:30", value)
+	}
+
+	return parsed, nil
+}
+
+// NormalizeTimeOfDay returns a time of day returned by the API, which may include seconds, in the HH:MM format.
+// Values with non-zero seconds or in another format are returned unchanged.
+func NormalizeTimeOfDay(value string) string {
+	parsed, err := time.Parse(timeOfDayWithSecondsLayout, value)
+	if err != nil || parsed.Second() != 0 {
+		return value
+	}
+
+	return parsed.Format(TimeOfDayLayout)
+}
+
+// LoadTimeZone loads an IANA time zone, e.g. Asia/Jerusalem. Unlike time.LoadLocation, it rejects the empty name
+// and Local, which depend on the host running Terraform.
+func LoadTimeZone(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("%q is not an IANA time zone name, e.g. Asia/Jerusalem or UTC", name)
+	}
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not an IANA time zone name, e.g. Asia/Jerusalem or UTC", name)
+	}
+
+	return location, nil
+}
+
+// TimeOfDayValidator validates that a string attribute is a time of day in the 24-hour HH:MM format.
+func TimeOfDayValidator() validator.String {
+	return timeOfDayValidator{}
+}
+
+type timeOfDayValidator struct{}
+
+func (v timeOfDayValidator) Description(_ context.Context) string {
+	return "value must be a time in the 24-hour HH:MM format"
+}
+
+func (v timeOfDayValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timeOfDayValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := ParseTimeOfDay(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Time of Day", err.Error())
+	}
+}
+
+// TimeZoneValidator validates that a string attribute is an IANA time zone name.
+func TimeZoneValidator() validator.String {
+	return timeZoneValidator{}
+}
+
+type timeZoneValidator struct{}
+
+func (v timeZoneValidator) Description(_ context.Context) string {
+	return "value must be an IANA time zone name"
+}
+
+func (v timeZoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timeZoneValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := LoadTimeZone(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Time Zone", err.Error())
+	}
+}
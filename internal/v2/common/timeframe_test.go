@@ -0,0 +1,38 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTimeOfDay(t *testing.T) {
+	for _, value := range []string{"00:00", "08:00", "17:30", "23:59"} {
+		_, err := ParseTimeOfDay(value)
+		assert.NoError(t, err, value)
+	}
+
+	for _, value := range []string{"", "8:00", "24:00", "12:60", "08:00:00", "8am", "08:00 "} {
+		_, err := ParseTimeOfDay(value)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestNormalizeTimeOfDay(t *testing.T) {
+	assert.Equal(t, "08:00", NormalizeTimeOfDay("08:00:00"))
+	assert.Equal(t, "08:00", NormalizeTimeOfDay("08:00"))
+	assert.Equal(t, "08:00:30", NormalizeTimeOfDay("08:00:30"))
+	assert.Equal(t, "invalid", NormalizeTimeOfDay("invalid"))
+}
+
+func TestLoadTimeZone(t *testing.T) {
+	for _, value := range []string{"UTC", "Asia/Jerusalem", "America/New_York"} {
+		_, err := LoadTimeZone(value)
+		assert.NoError(t, err, value)
+	}
+
+	for _, value := range []string{"", "Local", "Mars/Olympus_Mons", "asia/jerusalem-"} {
+		_, err := LoadTimeZone(value)
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,80 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// StateUpgraderFromV0 accepts state written before schema versioning was introduced, i.e. version 0, so that
+// bumping a resource's schema version to 1 doesn't fail for existing state. It translates nothing: the raw state
+// is decoded with the current schema, as the framework does for state of the current version. Attributes added
+// since are null and attributes removed since are ignored, and are refreshed from the API by the following read.
+func StateUpgraderFromV0(r resource.Resource) resource.StateUpgrader {
+	return StateUpgraderFromPriorTypes(r, nil, nil)
+}
+
+// StateUpgraderFromPriorTypes upgrades state in which some top-level attributes had other types. The raw state is
+// decoded with the current schema, except for those attributes, which are decoded with their prior types and
+// converted to the current schema by upgrade. Like StateUpgraderFromV0, attributes added since are null and
+// attributes removed since are ignored.
+func StateUpgraderFromPriorTypes(r resource.Resource, priorTypes map[string]tftypes.Type, upgrade func(name string, value tftypes.Value) (tftypes.Value, error)) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil {
+				resp.Diagnostics.AddError("Unable to Upgrade Resource State", "The raw state of the resource is missing.")
+				return
+			}
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			resp.Diagnostics.Append(schemaResp.Diagnostics...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			currentType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			if !ok {
+				resp.Diagnostics.AddError("Unable to Upgrade Resource State", "The schema of the resource is not an object.")
+				return
+			}
+
+			priorType := tftypes.Object{AttributeTypes: maps.Clone(currentType.AttributeTypes)}
+			maps.Copy(priorType.AttributeTypes, priorTypes)
+
+			rawState, err := req.RawState.UnmarshalWithOpts(priorType, tfprotov6.UnmarshalOpts{
+				ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+			})
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					fmt.Sprintf("Could not read the prior state of the resource: %v", err),
+				)
+				return
+			}
+
+			if len(priorTypes) > 0 {
+				var attributes map[string]tftypes.Value
+				if err := rawState.As(&attributes); err != nil {
+					resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Could not read the prior state of the resource: %v", err))
+					return
+				}
+
+				for name := range priorTypes {
+					if attributes[name], err = upgrade(name, attributes[name]); err != nil {
+						resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("Could not upgrade %s: %v", name, err))
+						return
+					}
+				}
+
+				rawState = tftypes.NewValue(currentType, attributes)
+			}
+
+			resp.State.Raw = rawState
+		},
+	}
+}
@@ -72,7 +72,7 @@ func (d *ResourceIntegrationsDataSource) Schema(ctx context.Context, req datasou
 							Computed:    true,
 						},
 						"integration_config": schema.MapAttribute{
-							MarkdownDescription: "Key-value integration-specific configuration. Refer to the [Integration Configuration documentation](https://docs.apono.io/metadata-for-integration-config) for specific configuration values.",
+							MarkdownDescription: "Key-value integration-specific configuration. Values that aren't strings, such as lists, are JSON-encoded. Refer to the [Integration Configuration documentation](https://docs.apono.io/metadata-for-integration-config) for specific configuration values.",
 							ElementType:         types.StringType,
 							Computed:            true,
 						},
@@ -96,9 +96,9 @@ func TestResourceIntegrationsDataSource_Read(t *testing.T) {
 	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
 	require.False(t, resp.Diagnostics.HasError(), "Error getting state: %s", resp.Diagnostics.Errors())
 
-	expectedModels := []models.ResourceIntegrationModel{}
+	expectedModels := []models.ResourceIntegrationDataModel{}
 	for _, integration := range integrations {
-		model, err := models.ResourceIntegrationToModel(ctx, &integration)
+		model, err := models.ResourceIntegrationToDataModel(ctx, &integration)
 		require.NoError(t, err, "Error converting integration to model")
 		expectedModels = append(expectedModels, *model)
 	}
@@ -70,7 +70,7 @@ func (d *AponoUserInformationIntegrationsDataSource) Schema(_ context.Context, _
 							Optional:    true,
 						},
 						"integration_config": schema.MapAttribute{
-							Description: "Integration-specific configuration that accepts key-value pairs.",
+							Description: "Integration-specific configuration that accepts key-value pairs. Values that aren't strings, such as lists, are JSON-encoded.",
 							ElementType: types.StringType,
 							Computed:    true,
 						},
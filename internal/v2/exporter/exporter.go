@@ -0,0 +1,228 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/resources"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/services"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Options controls which objects are exported.
+type Options struct {
+	// ResourceTypes limits the export to the given resource types, e.g. "apono_access_flow_v2". All types are exported when empty.
+	ResourceTypes []string
+
+	// ExcludeIDs are the IDs of objects that are already managed by Terraform and should not be exported.
+	ExcludeIDs map[string]struct{}
+}
+
+// exportedObject is a single Apono object and its Terraform model.
+type exportedObject struct {
+	id    string
+	name  string
+	model any
+}
+
+type resourceKind struct {
+	typeName string
+	resource resource.Resource
+	list     func(ctx context.Context, apiClient client.Invoker) ([]exportedObject, error)
+}
+
+// resourceKinds are ordered so that referenced objects are written before the objects referencing them.
+var resourceKinds = []resourceKind{
+	{
+		typeName: "apono_access_scope",
+		resource: &resources.AponoAccessScopeResource{},
+		list:     listAccessScopes,
+	},
+	{
+		typeName: "apono_managed_group",
+		resource: &resources.AponoManagedGroupResource{},
+		list:     listGroups,
+	},
+	{
+		typeName: "apono_resource_integration",
+		resource: &resources.AponoResourceIntegrationResource{},
+		list:     listResourceIntegrations,
+	},
+	{
+		typeName: "apono_bundle_v2",
+		resource: &resources.AponoBundleV2Resource{},
+		list:     listBundles,
+	},
+	{
+		typeName: "apono_access_flow_v2",
+		resource: &resources.AponoAccessFlowV2Resource{},
+		list:     listAccessFlows,
+	},
+}
+
+// ResourceTypes returns the resource types supported by the exporter.
+func ResourceTypes() []string {
+	var types []string
+	for _, kind := range resourceKinds {
+		types = append(types, kind.typeName)
+	}
+	return types
+}
+
+// Export lists the objects of the tenant and returns them as HCL resource blocks, each preceded by an import block.
+func Export(ctx context.Context, apiClient client.Invoker, opts Options) ([]byte, error) {
+	for _, resourceType := range opts.ResourceTypes {
+		if !slices.Contains(ResourceTypes(), resourceType) {
+			return nil, fmt.Errorf("unsupported resource type '%s', must be one of: %s", resourceType, strings.Join(ResourceTypes(), ", "))
+		}
+	}
+
+	w := newHCLWriter()
+	objectsByKind := map[string][]exportedObject{}
+
+	for _, kind := range resourceKinds {
+		if len(opts.ResourceTypes) > 0 && !slices.Contains(opts.ResourceTypes, kind.typeName) {
+			continue
+		}
+
+		objects, err := kind.list(ctx, apiClient)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", kind.typeName, err)
+		}
+
+		objects = slices.DeleteFunc(objects, func(object exportedObject) bool {
+			_, excluded := opts.ExcludeIDs[object.id]
+			return excluded
+		})
+
+		// Labels are assigned to all objects first, so references resolve regardless of the write order
+		for _, object := range objects {
+			w.addLabel(kind.typeName, object.id, object.name)
+		}
+
+		objectsByKind[kind.typeName] = objects
+	}
+
+	for _, kind := range resourceKinds {
+		for _, object := range objectsByKind[kind.typeName] {
+			if err := w.writeResource(ctx, kind.typeName, kind.resource, object); err != nil {
+				return nil, fmt.Errorf("failed to export %s '%s': %w", kind.typeName, object.name, err)
+			}
+		}
+	}
+
+	return w.bytes(), nil
+}
+
+func listAccessScopes(ctx context.Context, apiClient client.Invoker) ([]exportedObject, error) {
+	accessScopes, err := services.ListAccessScopesByName(ctx, apiClient, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []exportedObject
+	for _, accessScope := range accessScopes {
+		objects = append(objects, exportedObject{
+			id:    accessScope.ID,
+			name:  accessScope.Name,
+			model: services.AccessScopeToResourceModel(&accessScope),
+		})
+	}
+
+	return objects, nil
+}
+
+func listGroups(ctx context.Context, apiClient client.Invoker) ([]exportedObject, error) {
+	groups, err := services.ListGroups(ctx, apiClient, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []exportedObject
+	for _, group := range groups {
+		// Groups synced from an IdP are not managed groups and can't be managed by Terraform
+		if _, synced := group.SourceIntegrationID.Get(); synced {
+			continue
+		}
+
+		members, err := services.ListGroupMembers(ctx, apiClient, group.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list members of group '%s': %w", group.Name, err)
+		}
+
+		model := models.GroupToModel(&group)
+		membersSet, diags := models.GroupMembersToSet(ctx, members)
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to convert members of group '%s': %v", group.Name, diags.Errors())
+		}
+		model.Members = membersSet
+
+		objects = append(objects, exportedObject{id: group.ID, name: group.Name, model: model})
+	}
+
+	return objects, nil
+}
+
+func listResourceIntegrations(ctx context.Context, apiClient client.Invoker) ([]exportedObject, error) {
+	integrations, err := services.ListIntegrations(ctx, apiClient, "", "", "", []string{common.ResourceCategory})
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []exportedObject
+	for _, integration := range integrations {
+		model, err := models.ResourceIntegrationToModel(ctx, &integration)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert resource integration '%s': %w", integration.Name, err)
+		}
+
+		objects = append(objects, exportedObject{id: integration.ID, name: integration.Name, model: models.NewResourceIntegrationResourceModel(model, nil)})
+	}
+
+	return objects, nil
+}
+
+func listBundles(ctx context.Context, apiClient client.Invoker) ([]exportedObject, error) {
+	bundles, err := services.ListBundles(ctx, apiClient, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []exportedObject
+	for _, bundle := range bundles {
+		model, err := models.BundleResponseToModel(ctx, bundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert bundle '%s': %w", bundle.Name, err)
+		}
+		models.KeepConfiguredBundleTargetReferences(nil, model)
+
+		objects = append(objects, exportedObject{id: bundle.ID, name: bundle.Name, model: model})
+	}
+
+	return objects, nil
+}
+
+func listAccessFlows(ctx context.Context, apiClient client.Invoker) ([]exportedObject, error) {
+	accessFlows, err := services.ListAccessFlows(ctx, apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []exportedObject
+	for _, accessFlow := range accessFlows {
+		model, err := models.AccessFlowResponseToModel(ctx, accessFlow, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert access flow '%s': %w", accessFlow.Name, err)
+		}
+		models.KeepConfiguredAccessFlowTargetReferences(nil, model)
+
+		objects = append(objects, exportedObject{id: accessFlow.ID, name: accessFlow.Name, model: model})
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,110 @@
+package exporter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/mocks"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/testcommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	accessScopes := &client.PublicApiListResponseAccessScopePublicV1Model{
+		Items: []client.AccessScopeV1{
+			{ID: "scope-123", Name: "Test Scope", Query: `resource_type = "postgresql"`},
+			{ID: "scope-456", Name: "Test-Scope", Query: `resource_type = "mysql"`},
+		},
+	}
+	bundles := &client.PublicApiListResponseBundlePublicV2Model{
+		Items: []client.BundleV2{*testcommon.GenerateBundleResponse()},
+	}
+
+	t.Run("ExportWithReferences", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		mockInvoker.EXPECT().ListAccessScopesV1(mock.Anything, mock.Anything).Return(accessScopes, nil)
+		mockInvoker.EXPECT().ListBundlesV2(mock.Anything, mock.Anything).Return(bundles, nil)
+
+		config, err := Export(t.Context(), mockInvoker, Options{
+			ResourceTypes: []string{"apono_access_scope", "apono_bundle_v2"},
+		})
+		require.NoError(t, err)
+
+		output := string(config)
+		assert.Contains(t, output, `import {
+  to = apono_access_scope.test_scope
+  id = "scope-123"
+}`)
+		assert.Contains(t, output, `resource "apono_access_scope" "test_scope" {
+  name  = "Test Scope"
+  query = "resource_type = \"postgresql\""
+}`)
+		assert.Contains(t, output, `resource "apono_access_scope" "test_scope_2" {`)
+		assert.Contains(t, output, `resource "apono_bundle_v2" "test_bundle" {`)
+		assert.Contains(t, output, "name = apono_access_scope.test_scope.name")
+		// The integration isn't exported, so its name is kept as is
+		assert.Contains(t, output, `integration_name = "postgresql"`)
+		assert.NotContains(t, output, `id = "bundle-123"
+  name`)
+	})
+
+	t.Run("ExcludeIDs", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		mockInvoker.EXPECT().ListAccessScopesV1(mock.Anything, mock.Anything).Return(accessScopes, nil)
+		mockInvoker.EXPECT().ListBundlesV2(mock.Anything, mock.Anything).Return(bundles, nil)
+
+		config, err := Export(t.Context(), mockInvoker, Options{
+			ResourceTypes: []string{"apono_access_scope", "apono_bundle_v2"},
+			ExcludeIDs:    map[string]struct{}{"scope-123": {}, "bundle-123": {}},
+		})
+		require.NoError(t, err)
+
+		output := string(config)
+		assert.NotContains(t, output, "scope-123")
+		assert.NotContains(t, output, "apono_bundle_v2")
+		assert.Contains(t, output, `resource "apono_access_scope" "test_scope" {
+  name  = "Test-Scope"`)
+	})
+
+	t.Run("UnsupportedResourceType", func(t *testing.T) {
+		_, err := Export(t.Context(), mocks.NewInvoker(t), Options{ResourceTypes: []string{"apono_integration"}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported resource type 'apono_integration'")
+	})
+}
+
+func TestToLabel(t *testing.T) {
+	tests := map[string]string{
+		"Prod DB (RO)":  "prod_db_ro",
+		"postgresql":    "postgresql",
+		"  ":            "unnamed",
+		"2024 Auditors": "_2024_auditors",
+		"R&D / Admins":  "r_d_admins",
+	}
+
+	for name, expected := range tests {
+		assert.Equal(t, expected, toLabel(name), name)
+	}
+}
+
+func TestReadManagedIDs(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "terraform.tfstate")
+	state := `{
+  "version": 4,
+  "resources": [
+    {"mode": "managed", "type": "apono_access_flow_v2", "name": "a", "instances": [{"attributes": {"id": "flow-1"}}]},
+    {"mode": "managed", "type": "apono_access_bundle", "name": "b", "instances": [{"attributes": {"id": "bundle-1"}}]},
+    {"mode": "data", "type": "apono_bundles", "name": "c", "instances": [{"attributes": {"id": "data-1"}}]},
+    {"mode": "managed", "type": "aws_iam_role", "name": "d", "instances": [{"attributes": {"id": "role-1"}}]}
+  ]
+}`
+	require.NoError(t, os.WriteFile(statePath, []byte(state), 0o600))
+
+	ids, err := ReadManagedIDs(statePath)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]struct{}{"flow-1": {}, "bundle-1": {}}, ids)
+}
@@ -0,0 +1,325 @@
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// references maps attributes holding the name of another object to the resource type of that object.
+// Attributes are identified by their attribute names, ignoring list indexes.
+var references = map[string]string{
+	"access_targets.access_scope.name":            "apono_access_scope",
+	"access_targets.bundle.name":                  "apono_bundle_v2",
+	"access_targets.integration.integration_name": "apono_resource_integration",
+}
+
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+type hclWriter struct {
+	file *hclwrite.File
+
+	// labels holds the label of every exported object by resource type and ID
+	labels map[string]map[string]string
+	// labelsByName holds the labels by resource type and object name, used to resolve references.
+	// Names shared by more than one object map to an empty label and are not referenced.
+	labelsByName map[string]map[string]string
+	usedLabels   map[string]map[string]bool
+}
+
+func newHCLWriter() *hclWriter {
+	return &hclWriter{
+		file:         hclwrite.NewEmptyFile(),
+		labels:       map[string]map[string]string{},
+		labelsByName: map[string]map[string]string{},
+		usedLabels:   map[string]map[string]bool{},
+	}
+}
+
+// addLabel assigns a unique resource label to the object, derived from its name.
+func (w *hclWriter) addLabel(typeName, id, name string) {
+	if w.labels[typeName] == nil {
+		w.labels[typeName] = map[string]string{}
+		w.labelsByName[typeName] = map[string]string{}
+		w.usedLabels[typeName] = map[string]bool{}
+	}
+
+	base := toLabel(name)
+	label := base
+	for i := 2; w.usedLabels[typeName][label]; i++ {
+		label = fmt.Sprintf("%s_%d", base, i)
+	}
+
+	w.usedLabels[typeName][label] = true
+	w.labels[typeName][id] = label
+
+	if _, exists := w.labelsByName[typeName][name]; exists {
+		w.labelsByName[typeName][name] = ""
+	} else {
+		w.labelsByName[typeName][name] = label
+	}
+}
+
+// toLabel converts an object name to a valid Terraform resource label, e.g. "Prod DB (RO)" to "prod_db_ro".
+func toLabel(name string) string {
+	label := strings.Trim(invalidLabelChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" {
+		return "unnamed"
+	}
+
+	if label[0] >= '0' && label[0] <= '9' {
+		return "_" + label
+	}
+
+	return label
+}
+
+// writeResource writes the import block and the resource block of the object.
+func (w *hclWriter) writeResource(ctx context.Context, typeName string, r resource.Resource, object exportedObject) error {
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := state.Set(ctx, object.model); diags.HasError() {
+		return fmt.Errorf("failed to convert model: %v", diags.Errors())
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := state.Raw.As(&attributes); err != nil {
+		return err
+	}
+
+	label := w.labels[typeName][object.id]
+	body := w.file.Body()
+
+	importBody := body.AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: typeName},
+		hcl.TraverseAttr{Name: label},
+	})
+	importBody.SetAttributeValue("id", cty.StringVal(object.id))
+	body.AppendNewline()
+
+	resourceBody := body.AppendNewBlock("resource", []string{typeName, label}).Body()
+	for _, name := range sortedAttributeNames(attributes) {
+		tokens, err := w.attributeTokens(ctx, schemaResp.Schema, tftypes.NewAttributePath().WithAttributeName(name), attributes[name])
+		if err != nil {
+			return err
+		}
+
+		if tokens != nil {
+			resourceBody.SetAttributeRaw(name, tokens)
+		}
+	}
+	body.AppendNewline()
+
+	return nil
+}
+
+func (w *hclWriter) bytes() []byte {
+	return append(bytes.TrimRight(hclwrite.Format(w.file.Bytes()), "\n"), '\n')
+}
+
+// attributeTokens returns the tokens of an attribute value, or nil if the attribute should not be written
+// because it is null or computed-only.
+func (w *hclWriter) attributeTokens(ctx context.Context, s schema.Schema, p *tftypes.AttributePath, value tftypes.Value) (hclwrite.Tokens, error) {
+	if value.IsNull() || !value.IsKnown() {
+		return nil, nil
+	}
+
+	attribute, err := s.AttributeAtTerraformPath(ctx, p)
+	if err == nil && attribute.IsComputed() && !attribute.IsOptional() && !attribute.IsRequired() {
+		return nil, nil
+	}
+
+	return w.valueTokens(ctx, s, p, value)
+}
+
+func (w *hclWriter) valueTokens(ctx context.Context, s schema.Schema, p *tftypes.AttributePath, value tftypes.Value) (hclwrite.Tokens, error) {
+	switch {
+	case value.Type().Is(tftypes.Object{}):
+		var attributes map[string]tftypes.Value
+		if err := value.As(&attributes); err != nil {
+			return nil, err
+		}
+
+		var objectAttrs []hclwrite.ObjectAttrTokens
+		for _, name := range sortedAttributeNames(attributes) {
+			tokens, err := w.attributeTokens(ctx, s, p.WithAttributeName(name), attributes[name])
+			if err != nil {
+				return nil, err
+			}
+
+			if tokens != nil {
+				objectAttrs = append(objectAttrs, hclwrite.ObjectAttrTokens{Name: hclwrite.TokensForIdentifier(name), Value: tokens})
+			}
+		}
+
+		return hclwrite.TokensForObject(objectAttrs), nil
+
+	case value.Type().Is(tftypes.Map{}):
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+
+		keys := make([]string, 0, len(elements))
+		for key := range elements {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var objectAttrs []hclwrite.ObjectAttrTokens
+		for _, key := range keys {
+			tokens, err := w.valueTokens(ctx, s, p.WithElementKeyString(key), elements[key])
+			if err != nil {
+				return nil, err
+			}
+
+			objectAttrs = append(objectAttrs, hclwrite.ObjectAttrTokens{Name: hclwrite.TokensForValue(cty.StringVal(key)), Value: tokens})
+		}
+
+		return hclwrite.TokensForObject(objectAttrs), nil
+
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+
+		var elementTokens []hclwrite.Tokens
+		for i, element := range elements {
+			elementPath := p.WithElementKeyInt(i)
+			if value.Type().Is(tftypes.Set{}) {
+				elementPath = p.WithElementKeyValue(element)
+			}
+
+			tokens, err := w.valueTokens(ctx, s, elementPath, element)
+			if err != nil {
+				return nil, err
+			}
+
+			elementTokens = append(elementTokens, tokens)
+		}
+
+		return tupleTokens(elementTokens), nil
+
+	case value.Type().Is(tftypes.String):
+		var str string
+		if err := value.As(&str); err != nil {
+			return nil, err
+		}
+
+		if tokens := w.referenceTokens(p, str); tokens != nil {
+			return tokens, nil
+		}
+
+		return hclwrite.TokensForValue(cty.StringVal(str)), nil
+
+	case value.Type().Is(tftypes.Number):
+		number := new(big.Float)
+		if err := value.As(&number); err != nil {
+			return nil, err
+		}
+
+		return hclwrite.TokensForValue(cty.NumberVal(number)), nil
+
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+		if err := value.As(&b); err != nil {
+			return nil, err
+		}
+
+		return hclwrite.TokensForValue(cty.BoolVal(b)), nil
+	}
+
+	return nil, fmt.Errorf("unsupported value type %s at %s", value.Type(), p)
+}
+
+// tupleTokens returns a tuple with one element per line when the elements are objects, which is how
+// terraform fmt lays out lists of objects, and a single line tuple otherwise.
+func tupleTokens(elements []hclwrite.Tokens) hclwrite.Tokens {
+	multiline := false
+	for _, element := range elements {
+		if len(element) > 0 && element[0].Type == hclsyntax.TokenOBrace {
+			multiline = true
+		}
+	}
+
+	if !multiline {
+		return hclwrite.TokensForTuple(elements)
+	}
+
+	tokens := hclwrite.Tokens{{Type: hclsyntax.TokenOBrack, Bytes: []byte("[")}, {Type: hclsyntax.TokenNewline, Bytes: []byte("\n")}}
+	for _, element := range elements {
+		tokens = append(tokens, element...)
+		tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenComma, Bytes: []byte(",")}, &hclwrite.Token{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")})
+	}
+
+	return append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCBrack, Bytes: []byte("]")})
+}
+
+// referenceTokens returns a reference to the name of an exported object, e.g. apono_access_scope.prod.name,
+// or nil if the value isn't the name of exactly one exported object.
+func (w *hclWriter) referenceTokens(p *tftypes.AttributePath, name string) hclwrite.Tokens {
+	typeName, ok := references[attributeNamesPath(p)]
+	if !ok {
+		return nil
+	}
+
+	label := w.labelsByName[typeName][name]
+	if label == "" {
+		return nil
+	}
+
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: typeName},
+		hcl.TraverseAttr{Name: label},
+		hcl.TraverseAttr{Name: "name"},
+	})
+}
+
+// attributeNamesPath returns the attribute names of the path joined by dots, ignoring element keys.
+func attributeNamesPath(p *tftypes.AttributePath) string {
+	var names []string
+	for _, step := range p.Steps() {
+		if name, ok := step.(tftypes.AttributeName); ok {
+			names = append(names, string(name))
+		}
+	}
+
+	return strings.Join(names, ".")
+}
+
+// sortedAttributeNames sorts attributes by name, with the name attribute first for readability.
+func sortedAttributeNames(attributes map[string]tftypes.Value) []string {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		if names[i] == "name" || names[j] == "name" {
+			return names[i] == "name"
+		}
+		return names[i] < names[j]
+	})
+
+	return names
+}
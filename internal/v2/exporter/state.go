@@ -0,0 +1,48 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ReadManagedIDs returns the IDs of the Apono objects managed in a Terraform state file, as written by
+// `terraform state pull`. The v1 and v2 resources share the same IDs, so objects still managed by
+// v1 resources are included as well.
+func ReadManagedIDs(path string) (map[string]struct{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var state struct {
+		Resources []struct {
+			Mode      string `json:"mode"`
+			Type      string `json:"type"`
+			Instances []struct {
+				Attributes struct {
+					ID string `json:"id"`
+				} `json:"attributes"`
+			} `json:"instances"`
+		} `json:"resources"`
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse state file %s: %w", path, err)
+	}
+
+	ids := map[string]struct{}{}
+	for _, r := range state.Resources {
+		if r.Mode != "managed" || !strings.HasPrefix(r.Type, "apono_") {
+			continue
+		}
+
+		for _, instance := range r.Instances {
+			if instance.Attributes.ID != "" {
+				ids[instance.Attributes.ID] = struct{}{}
+			}
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,81 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/aql"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &AQLFunction{}
+
+// aqlFieldPattern matches an AQL field, optionally with a quoted key, e.g. resource_type or resource_tag["env"].
+var aqlFieldPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*(\["([^"\\]|\\.)*"\])?$`)
+
+type AQLFunction struct{}
+
+func NewAQLFunction() function.Function {
+	return &AQLFunction{}
+}
+
+func (f *AQLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "aql"
+}
+
+func (f *AQLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds an Apono Query Language (AQL) expression.",
+		MarkdownDescription: "Builds an [Apono Query Language](https://docs.apono.io/docs/inventory/apono-query-language) expression " +
+			"that compares a field to one or more values, quoting and escaping the values. " +
+			"Multiple values are combined with `or` for `=` and `contains`, and with `and` for `!=` and `not contains`. " +
+			"Expressions can be combined with `join(\" and \", [...])` and used in `apono_access_scope.query`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "field",
+				Description: "The field to compare, e.g. resource_type or resource_tag[\"env\"].",
+			},
+			function.StringParameter{
+				Name:        "operator",
+				Description: "The comparison operator. Possible values: " + strings.Join(aql.Operators, ", ") + ".",
+			},
+			function.ListParameter{
+				Name:        "values",
+				Description: "The values to compare the field to.",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *AQLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var field, operator string
+	var values []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &field, &operator, &values))
+	if resp.Error != nil {
+		return
+	}
+
+	if !aqlFieldPattern.MatchString(field) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid field %q: expected a field such as resource_type or resource_tag[\"env\"]", field))
+		return
+	}
+
+	operator = strings.Join(strings.Fields(strings.ToLower(operator)), " ")
+	if !slices.Contains(aql.Operators, operator) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("invalid operator %q: possible values are %s", operator, strings.Join(aql.Operators, ", ")))
+		return
+	}
+
+	if len(values) == 0 {
+		resp.Error = function.NewArgumentFuncError(2, "at least one value is required")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, aql.Build(field, operator, values)))
+}
@@ -0,0 +1,55 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAQLFunction(t *testing.T) {
+	tests := []struct {
+		name     string
+		field    string
+		operator string
+		values   []string
+		expected string
+	}{
+		{"Equals", "resource_type", "=", []string{"aws-rds-mysql-database"}, `resource_type = "aws-rds-mysql-database"`},
+		{"Tag", `resource_tag["env"]`, "=", []string{"production"}, `resource_tag["env"] = "production"`},
+		{"EqualsMultiple", "resource_type", "=", []string{"a", "b"}, `(resource_type = "a" or resource_type = "b")`},
+		{"NotContainsMultiple", "resource_name", "not  contains", []string{"dev", "test"}, `(resource_name not contains "dev" and resource_name not contains "test")`},
+		{"In", "resource_risk_level", "in", []string{"1", "2"}, `resource_risk_level in ("1", "2")`},
+		{"Escaping", "resource_name", "contains", []string{`say "hi" \o/`}, `resource_name contains "say \"hi\" \\o/"`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := runFunction(t, NewAQLFunction(), types.StringUnknown(),
+				types.StringValue(test.field), types.StringValue(test.operator), stringList(test.values...))
+			require.Nil(t, resp.Error)
+			assert.Equal(t, types.StringValue(test.expected), resp.Result.Value())
+		})
+	}
+
+	t.Run("InvalidField", func(t *testing.T) {
+		resp := runFunction(t, NewAQLFunction(), types.StringUnknown(),
+			types.StringValue(`resource_type = "x" or 1`), types.StringValue("="), stringList("a"))
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, int64(0), *resp.Error.FunctionArgument)
+	})
+
+	t.Run("InvalidOperator", func(t *testing.T) {
+		resp := runFunction(t, NewAQLFunction(), types.StringUnknown(),
+			types.StringValue("resource_type"), types.StringValue("like"), stringList("a"))
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, int64(1), *resp.Error.FunctionArgument)
+	})
+
+	t.Run("NoValues", func(t *testing.T) {
+		resp := runFunction(t, NewAQLFunction(), types.StringUnknown(),
+			types.StringValue("resource_type"), types.StringValue("="), stringList())
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, int64(2), *resp.Error.FunctionArgument)
+	})
+}
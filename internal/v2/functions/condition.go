@@ -0,0 +1,112 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ConditionFunction{}
+
+var conditionAttributeTypes = map[string]attr.Type{
+	"source_integration_name": types.StringType,
+	"type":                    types.StringType,
+	"match_operator":          types.StringType,
+	"values":                  types.ListType{ElemType: types.StringType},
+}
+
+type ConditionFunction struct{}
+
+func NewConditionFunction() function.Function {
+	return &ConditionFunction{}
+}
+
+func (f *ConditionFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "condition"
+}
+
+func (f *ConditionFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds an identity condition of an access flow.",
+		MarkdownDescription: "Builds an identity condition object, for use in the `approvers` of an approver or escalation policy " +
+			"and in the `conditions` of the requestors or grantees of `apono_access_flow_v2`. " +
+			"An optional fourth argument sets the `source_integration_name` of the condition.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "type",
+				Description: "Identity type, e.g. user, group or manager.",
+			},
+			function.ListParameter{
+				Name:        "values",
+				Description: "Values according to the identity type and match operator, e.g. user emails or group IDs.",
+				ElementType: types.StringType,
+			},
+			function.StringParameter{
+				Name:           "match_operator",
+				Description:    "Comparison operator. Possible values: " + strings.Join(common.MatchOperators, ", ") + ". Defaults to is when null.",
+				AllowNullValue: true,
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "source_integration_name",
+			Description: "The integration the identity stems from.",
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: conditionAttributeTypes,
+		},
+	}
+}
+
+func (f *ConditionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var conditionType string
+	var values types.List
+	var matchOperator types.String
+	var sourceIntegrationNames []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &conditionType, &values, &matchOperator, &sourceIntegrationNames))
+	if resp.Error != nil {
+		return
+	}
+
+	if strings.TrimSpace(conditionType) == "" {
+		resp.Error = function.NewArgumentFuncError(0, "type must not be empty")
+		return
+	}
+
+	operator := common.DefaultMatchOperator
+	if !matchOperator.IsNull() {
+		operator = matchOperator.ValueString()
+	}
+	if !slices.Contains(common.MatchOperators, operator) {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("invalid match_operator %q: possible values are %s", operator, strings.Join(common.MatchOperators, ", ")))
+		return
+	}
+
+	sourceIntegrationName := types.StringNull()
+	switch len(sourceIntegrationNames) {
+	case 0:
+	case 1:
+		sourceIntegrationName = types.StringValue(sourceIntegrationNames[0])
+	default:
+		resp.Error = function.NewArgumentFuncError(3, "at most one source_integration_name can be set")
+		return
+	}
+
+	condition, diags := types.ObjectValue(conditionAttributeTypes, map[string]attr.Value{
+		"source_integration_name": sourceIntegrationName,
+		"type":                    types.StringValue(conditionType),
+		"match_operator":          types.StringValue(operator),
+		"values":                  values,
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, condition))
+}
@@ -0,0 +1,60 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionFunction(t *testing.T) {
+	unknown := types.ObjectUnknown(conditionAttributeTypes)
+
+	t.Run("Condition", func(t *testing.T) {
+		resp := runFunction(t, NewConditionFunction(), unknown,
+			types.StringValue("group"), stringList("admins"), types.StringValue("is_not"),
+			types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("Okta")}))
+		require.Nil(t, resp.Error)
+
+		expected := types.ObjectValueMust(conditionAttributeTypes, map[string]attr.Value{
+			"source_integration_name": types.StringValue("Okta"),
+			"type":                    types.StringValue("group"),
+			"match_operator":          types.StringValue("is_not"),
+			"values":                  stringList("admins"),
+		})
+		assert.Equal(t, expected, resp.Result.Value())
+	})
+
+	t.Run("DefaultMatchOperator", func(t *testing.T) {
+		resp := runFunction(t, NewConditionFunction(), unknown,
+			types.StringValue("user"), stringList("person@example.com"), types.StringNull(),
+			types.TupleValueMust([]attr.Type{}, []attr.Value{}))
+		require.Nil(t, resp.Error)
+
+		expected := types.ObjectValueMust(conditionAttributeTypes, map[string]attr.Value{
+			"source_integration_name": types.StringNull(),
+			"type":                    types.StringValue("user"),
+			"match_operator":          types.StringValue("is"),
+			"values":                  stringList("person@example.com"),
+		})
+		assert.Equal(t, expected, resp.Result.Value())
+	})
+
+	t.Run("InvalidMatchOperator", func(t *testing.T) {
+		resp := runFunction(t, NewConditionFunction(), unknown,
+			types.StringValue("user"), stringList("a"), types.StringValue("equals"),
+			types.TupleValueMust([]attr.Type{}, []attr.Value{}))
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, int64(2), *resp.Error.FunctionArgument)
+	})
+
+	t.Run("MultipleSourceIntegrations", func(t *testing.T) {
+		resp := runFunction(t, NewConditionFunction(), unknown,
+			types.StringValue("user"), stringList("a"), types.StringNull(),
+			types.TupleValueMust([]attr.Type{types.StringType, types.StringType}, []attr.Value{types.StringValue("a"), types.StringValue("b")}))
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, int64(3), *resp.Error.FunctionArgument)
+	})
+}
@@ -0,0 +1,101 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &DurationMinutesFunction{}
+
+type DurationMinutesFunction struct{}
+
+func NewDurationMinutesFunction() function.Function {
+	return &DurationMinutesFunction{}
+}
+
+func (f *DurationMinutesFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "duration_minutes"
+}
+
+func (f *DurationMinutesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a duration string to a number of minutes.",
+		MarkdownDescription: "Converts a duration string, such as `\"8h\"`, `\"1h30m\"` or `\"2d\"`, to a whole number of minutes, " +
+			"for use in attributes such as `grant_duration_in_min`. Supported units are `m`, `h` and `d`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "duration",
+				Description: "The duration, e.g. 8h, 1h30m or 2d.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *DurationMinutesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var duration string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &duration))
+	if resp.Error != nil {
+		return
+	}
+
+	minutes, err := parseDurationMinutes(duration)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, minutes))
+}
+
+// maxDurationDays is the largest number of days that fits in a time.Duration.
+const maxDurationDays = math.MaxInt64 / int64(24*time.Hour)
+
+// durationPattern matches an optional whole number of days followed by hours and minutes, e.g. 2d, 1h30m or 1d12h.
+var durationPattern = regexp.MustCompile(`^(?:([0-9]+)d)?((?:[0-9]+(?:\.[0-9]+)?[hm])*)$`)
+
+// parseDurationMinutes parses a duration string in days, hours and minutes, and returns it in whole minutes.
+func parseDurationMinutes(duration string) (int64, error) {
+	value := strings.TrimSpace(duration)
+	if value == "" {
+		return 0, fmt.Errorf("duration must not be empty")
+	}
+
+	match := durationPattern.FindStringSubmatch(value)
+	if match == nil {
+		return 0, fmt.Errorf("invalid duration %q: expected a duration such as 30m, 8h, 1h30m or 2d", duration)
+	}
+
+	var total time.Duration
+	if match[1] != "" {
+		days, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || days > maxDurationDays {
+			return 0, fmt.Errorf("invalid duration %q: the duration must not exceed %d days", duration, maxDurationDays)
+		}
+		total = time.Duration(days) * 24 * time.Hour
+	}
+
+	if match[2] != "" {
+		parsed, err := time.ParseDuration(match[2])
+		if err != nil || total > math.MaxInt64-parsed {
+			return 0, fmt.Errorf("invalid duration %q: the duration must not exceed %d days", duration, maxDurationDays)
+		}
+		total += parsed
+	}
+
+	if total <= 0 {
+		return 0, fmt.Errorf("invalid duration %q: the duration must be positive", duration)
+	}
+	if total%time.Minute != 0 {
+		return 0, fmt.Errorf("invalid duration %q: the duration must be a whole number of minutes", duration)
+	}
+
+	return int64(total / time.Minute), nil
+}
@@ -0,0 +1,54 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDurationMinutesFunction(t *testing.T) {
+	tests := map[string]int64{
+		"30m":     30,
+		"8h":      480,
+		"1h30m":   90,
+		"2d":      2880,
+		"1d12h":   2160,
+		" 90m ":   90,
+		"106751d": 153721440,
+	}
+	for duration, expected := range tests {
+		t.Run(duration, func(t *testing.T) {
+			resp := runFunction(t, NewDurationMinutesFunction(), types.Int64Unknown(), types.StringValue(duration))
+			require.Nil(t, resp.Error)
+			assert.Equal(t, types.Int64Value(expected), resp.Result.Value())
+		})
+	}
+
+	for _, duration := range []string{"", "8", "1x", "0m", "90s", "d", "-1h"} {
+		t.Run("Invalid"+duration, func(t *testing.T) {
+			resp := runFunction(t, NewDurationMinutesFunction(), types.Int64Unknown(), types.StringValue(duration))
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, int64(0), *resp.Error.FunctionArgument)
+		})
+	}
+
+	// Signs and units other than d, h and m are rejected rather than passed through to time.ParseDuration.
+	for _, duration := range []string{"2d-1h", "90s", "120s", "1h30s", "1d60000ms", "+1d", "1d+1h"} {
+		t.Run("UnsupportedFormat"+duration, func(t *testing.T) {
+			resp := runFunction(t, NewDurationMinutesFunction(), types.Int64Unknown(), types.StringValue(duration))
+			require.NotNil(t, resp.Error)
+			assert.Contains(t, resp.Error.Text, "expected a duration such as 30m, 8h, 1h30m or 2d")
+		})
+	}
+
+	// Durations that don't fit in a time.Duration are rejected instead of wrapping around.
+	for _, duration := range []string{"106752d", "213504d", "2147483647d", "106751d24h", "99999999999999999999d"} {
+		t.Run("TooLong"+duration, func(t *testing.T) {
+			resp := runFunction(t, NewDurationMinutesFunction(), types.Int64Unknown(), types.StringValue(duration))
+			require.NotNil(t, resp.Error)
+			assert.Contains(t, resp.Error.Text, "must not exceed 106751 days")
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ValidateTimeframeFunction{}
+
+var timeframeAttributeTypes = map[string]attr.Type{
+	"start_time":   types.StringType,
+	"end_time":     types.StringType,
+	"days_of_week": types.SetType{ElemType: types.StringType},
+	"time_zone":    types.StringType,
+}
+
+type ValidateTimeframeFunction struct{}
+
+func NewValidateTimeframeFunction() function.Function {
+	return &ValidateTimeframeFunction{}
+}
+
+func (f *ValidateTimeframeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_timeframe"
+}
+
+func (f *ValidateTimeframeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validates the timeframe of an access flow.",
+		MarkdownDescription: "Validates the timeframe of an access flow and returns it as an object that can be assigned to the " +
+			"`timeframe` attribute of `apono_access_flow_v2`. Times use the 24-hour `HH:MM` format, the start time must be " +
+			"before the end time, days are weekday names such as `MONDAY` and the time zone is an IANA time zone name. " +
+			"Day names are returned in upper case.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "start_time",
+				Description: "Start time, e.g. 08:00.",
+			},
+			function.StringParameter{
+				Name:        "end_time",
+				Description: "End time, e.g. 17:00.",
+			},
+			function.ListParameter{
+				Name:        "days_of_week",
+				Description: "Days when access is allowed, e.g. [\"MONDAY\", \"TUESDAY\"].",
+				ElementType: types.StringType,
+			},
+			function.StringParameter{
+				Name:        "time_zone",
+				Description: "Time zone name, e.g. Asia/Jerusalem.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: timeframeAttributeTypes,
+		},
+	}
+}
+
+func (f *ValidateTimeframeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var startTime, endTime, timeZone string
+	var days []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &startTime, &endTime, &days, &timeZone))
+	if resp.Error != nil {
+		return
+	}
+
+	start, err := common.ParseTimeOfDay(startTime)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid start_time %q: expected a time in HH:MM format, e.g. 08:00", startTime))
+		return
+	}
+
+	end, err := common.ParseTimeOfDay(endTime)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("invalid end_time %q: expected a time in HH:MM format, e.g. 17:00", endTime))
+		return
+	}
+
+	if !start.Before(end) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("end_time %q must be after start_time %q", endTime, startTime))
+		return
+	}
+
+	if len(days) == 0 {
+		resp.Error = function.NewArgumentFuncError(2, "at least one day is required")
+		return
+	}
+
+	dayValues := make([]attr.Value, 0, len(days))
+	for _, day := range days {
+		normalized := strings.ToUpper(strings.TrimSpace(day))
+		if !slices.Contains(common.DaysOfWeek, normalized) {
+			resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("invalid day %q: possible values are %s", day, strings.Join(common.DaysOfWeek, ", ")))
+			return
+		}
+		if !slices.Contains(dayValues, attr.Value(types.StringValue(normalized))) {
+			dayValues = append(dayValues, types.StringValue(normalized))
+		}
+	}
+
+	if timeZone == "" {
+		resp.Error = function.NewArgumentFuncError(3, "time_zone must not be empty")
+		return
+	}
+	if _, err := common.LoadTimeZone(timeZone); err != nil {
+		resp.Error = function.NewArgumentFuncError(3, fmt.Sprintf("invalid time_zone %q: expected an IANA time zone name, e.g. Asia/Jerusalem", timeZone))
+		return
+	}
+
+	daysSet, diags := types.SetValue(types.StringType, dayValues)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	timeframe, diags := types.ObjectValue(timeframeAttributeTypes, map[string]attr.Value{
+		"start_time":   types.StringValue(startTime),
+		"end_time":     types.StringValue(endTime),
+		"days_of_week": daysSet,
+		"time_zone":    types.StringValue(timeZone),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, timeframe))
+}
@@ -0,0 +1,52 @@
+package functions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTimeframeFunction(t *testing.T) {
+	unknown := types.ObjectUnknown(timeframeAttributeTypes)
+
+	t.Run("Valid", func(t *testing.T) {
+		resp := runFunction(t, NewValidateTimeframeFunction(), unknown,
+			types.StringValue("08:00"), types.StringValue("17:30"), stringList("monday", "FRIDAY", "Monday"), types.StringValue("Asia/Jerusalem"))
+		require.Nil(t, resp.Error)
+
+		expected := types.ObjectValueMust(timeframeAttributeTypes, map[string]attr.Value{
+			"start_time":   types.StringValue("08:00"),
+			"end_time":     types.StringValue("17:30"),
+			"days_of_week": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("MONDAY"), types.StringValue("FRIDAY")}),
+			"time_zone":    types.StringValue("Asia/Jerusalem"),
+		})
+		assert.Equal(t, expected, resp.Result.Value())
+	})
+
+	tests := []struct {
+		name     string
+		start    string
+		end      string
+		days     []string
+		timeZone string
+		argument int64
+	}{
+		{"InvalidStartTime", "8am", "17:00", []string{"MONDAY"}, "UTC", 0},
+		{"InvalidEndTime", "08:00", "24:00", []string{"MONDAY"}, "UTC", 1},
+		{"EndBeforeStart", "17:00", "08:00", []string{"MONDAY"}, "UTC", 1},
+		{"NoDays", "08:00", "17:00", []string{}, "UTC", 2},
+		{"InvalidDay", "08:00", "17:00", []string{"MON"}, "UTC", 2},
+		{"InvalidTimeZone", "08:00", "17:00", []string{"MONDAY"}, "Mars/Olympus_Mons", 3},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := runFunction(t, NewValidateTimeframeFunction(), unknown,
+				types.StringValue(test.start), types.StringValue(test.end), stringList(test.days...), types.StringValue(test.timeZone))
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, test.argument, *resp.Error.FunctionArgument)
+		})
+	}
+}
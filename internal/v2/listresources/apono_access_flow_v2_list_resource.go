@@ -0,0 +1,91 @@
+package listresources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/services"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResourceWithConfigure = &AponoAccessFlowV2ListResource{}
+
+func NewAponoAccessFlowV2ListResource() list.ListResource {
+	return &AponoAccessFlowV2ListResource{}
+}
+
+type AponoAccessFlowV2ListResource struct {
+	client   client.Invoker
+	settings common.ProviderSettings
+}
+
+func (r *AponoAccessFlowV2ListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_flow_v2"
+}
+
+func (r *AponoAccessFlowV2ListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists existing Apono Access Flows, optionally filtered by name.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Filter by access flow name. Only access flows whose name contains the given value are listed. Matching is case-insensitive.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *AponoAccessFlowV2ListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	common.ConfigureResourceClientInvoker(ctx, req, resp, &r.client)
+	common.ConfigureResourceSettings(ctx, req, resp, &r.settings)
+}
+
+func (r *AponoAccessFlowV2ListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config models.NameListConfigModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Listing access flows", map[string]any{
+		"name_filter": config.Name.ValueString(),
+	})
+
+	accessFlows, err := services.ListAccessFlows(ctx, r.client)
+	if err != nil {
+		stream.Results = errorResults("Error listing access flows", fmt.Sprintf("Could not list access flows: %v", err))
+		return
+	}
+
+	// The access flows API has no name filter, so the filter is applied locally
+	if nameFilter := strings.ToLower(config.Name.ValueString()); nameFilter != "" {
+		filtered := []client.AccessFlowV2{}
+		for _, accessFlow := range accessFlows {
+			if strings.Contains(strings.ToLower(accessFlow.Name), nameFilter) {
+				filtered = append(filtered, accessFlow)
+			}
+		}
+		accessFlows = filtered
+	}
+
+	stream.Results = streamResults(ctx, req, accessFlows, func(accessFlow client.AccessFlowV2) list.ListResult {
+		return newListResult(ctx, req, accessFlow.ID, accessFlow.Name, func() (any, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			model, err := models.AccessFlowResponseToModel(ctx, accessFlow, r.settings.DefaultLabels)
+			if err != nil {
+				diags.AddError("Error converting access flow", fmt.Sprintf("Could not convert access flow ID %s: %v", accessFlow.ID, err))
+			}
+			models.KeepConfiguredAccessFlowTargetReferences(nil, model)
+			return model, diags
+		})
+	})
+}
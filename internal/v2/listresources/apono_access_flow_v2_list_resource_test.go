@@ -0,0 +1,49 @@
+package listresources
+
+import (
+	"testing"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/mocks"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/resources"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/testcommon"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAponoAccessFlowV2ListResource(t *testing.T) {
+	t.Run("ListFilteredByName", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r := &AponoAccessFlowV2ListResource{client: mockInvoker}
+
+		mockResponse := testcommon.GenerateAccessFlowResponse()
+		mockResponse.Name = "Production DB Access"
+
+		mockInvoker.EXPECT().
+			ListAccessFlowsV2(mock.Anything, mock.Anything).
+			Return(&client.PublicApiListResponseAccessFlowPublicV2Model{
+				Items: []client.AccessFlowV2{*mockResponse, {ID: "other", Name: "Staging"}},
+			}, nil)
+
+		config := models.NameListConfigModel{Name: types.StringValue("production")}
+		req := newTestListRequest(t, r, &resources.AponoAccessFlowV2Resource{}, config, true)
+
+		results := collectResults(t, r, req)
+		require.Len(t, results, 1)
+
+		identity := getResultIdentity(t, results[0])
+		assert.Equal(t, mockResponse.ID, identity.ID.ValueString())
+
+		expected, err := models.AccessFlowResponseToModel(t.Context(), *mockResponse, nil)
+		require.NoError(t, err)
+		models.KeepConfiguredAccessFlowTargetReferences(nil, expected)
+
+		var got models.AccessFlowV2Model
+		diags := results[0].Resource.Get(t.Context(), &got)
+		require.False(t, diags.HasError(), "Error getting resource: %s", diags.Errors())
+		assert.Equal(t, *expected, got)
+	})
+}
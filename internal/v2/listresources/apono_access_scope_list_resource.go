@@ -0,0 +1,71 @@
+package listresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/services"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResourceWithConfigure = &AponoAccessScopeListResource{}
+
+func NewAponoAccessScopeListResource() list.ListResource {
+	return &AponoAccessScopeListResource{}
+}
+
+type AponoAccessScopeListResource struct {
+	client client.Invoker
+}
+
+func (r *AponoAccessScopeListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_scope"
+}
+
+func (r *AponoAccessScopeListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists existing Apono Access Scopes, optionally filtered by name.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Filters the listed access scopes by their name. Partial matching is supported with asterisks for contains, starts with, and ends with. Matching is case-insensitive.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *AponoAccessScopeListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	common.ConfigureResourceClientInvoker(ctx, req, resp, &r.client)
+}
+
+func (r *AponoAccessScopeListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config models.NameListConfigModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Listing access scopes", map[string]any{
+		"name_filter": config.Name.ValueString(),
+	})
+
+	accessScopes, err := services.ListAccessScopesByName(ctx, r.client, config.Name.ValueString())
+	if err != nil {
+		stream.Results = errorResults("Error listing access scopes", fmt.Sprintf("Could not list access scopes: %v", err))
+		return
+	}
+
+	stream.Results = streamResults(ctx, req, accessScopes, func(accessScope client.AccessScopeV1) list.ListResult {
+		return newListResult(ctx, req, accessScope.ID, accessScope.Name, func() (any, diag.Diagnostics) {
+			return services.AccessScopeToResourceModel(&accessScope), nil
+		})
+	})
+}
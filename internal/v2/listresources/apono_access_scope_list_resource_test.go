@@ -0,0 +1,41 @@
+package listresources
+
+import (
+	"testing"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/mocks"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/resources"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/services"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAponoAccessScopeListResource(t *testing.T) {
+	t.Run("List", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r := &AponoAccessScopeListResource{client: mockInvoker}
+
+		accessScope := client.AccessScopeV1{ID: "scope-1", Name: "Production", Query: `resource_type = "postgresql"`}
+		mockInvoker.EXPECT().
+			ListAccessScopesV1(mock.Anything, mock.Anything).
+			Return(&client.PublicApiListResponseAccessScopePublicV1Model{Items: []client.AccessScopeV1{accessScope}}, nil)
+
+		req := newTestListRequest(t, r, &resources.AponoAccessScopeResource{}, models.NameListConfigModel{Name: types.StringNull()}, true)
+
+		results := collectResults(t, r, req)
+		require.Len(t, results, 1)
+
+		identity := getResultIdentity(t, results[0])
+		assert.Equal(t, "scope-1", identity.ID.ValueString())
+		assert.Equal(t, "Production", identity.Name.ValueString())
+
+		var got services.AccessScopeResourceModel
+		diags := results[0].Resource.Get(t.Context(), &got)
+		require.False(t, diags.HasError(), "Error getting resource: %s", diags.Errors())
+		assert.Equal(t, *services.AccessScopeToResourceModel(&accessScope), got)
+	})
+}
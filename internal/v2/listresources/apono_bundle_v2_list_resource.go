@@ -0,0 +1,77 @@
+package listresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/services"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResourceWithConfigure = &AponoBundleV2ListResource{}
+
+func NewAponoBundleV2ListResource() list.ListResource {
+	return &AponoBundleV2ListResource{}
+}
+
+type AponoBundleV2ListResource struct {
+	client client.Invoker
+}
+
+func (r *AponoBundleV2ListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bundle_v2"
+}
+
+func (r *AponoBundleV2ListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists existing Apono Bundles, optionally filtered by name.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: `Filter by bundle name. Partial matching is supported with asterisks for contains, starts with, and ends with. (e.g., "prod*"). Matching is case-insensitive.`,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *AponoBundleV2ListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	common.ConfigureResourceClientInvoker(ctx, req, resp, &r.client)
+}
+
+func (r *AponoBundleV2ListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config models.NameListConfigModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Listing bundles", map[string]any{
+		"name_filter": config.Name.ValueString(),
+	})
+
+	bundles, err := services.ListBundles(ctx, r.client, config.Name.ValueString())
+	if err != nil {
+		stream.Results = errorResults("Error listing bundles", fmt.Sprintf("Could not list bundles: %v", err))
+		return
+	}
+
+	stream.Results = streamResults(ctx, req, bundles, func(bundle client.BundleV2) list.ListResult {
+		return newListResult(ctx, req, bundle.ID, bundle.Name, func() (any, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			model, err := models.BundleResponseToModel(ctx, bundle)
+			if err != nil {
+				diags.AddError("Error converting bundle", fmt.Sprintf("Could not convert bundle ID %s: %v", bundle.ID, err))
+			}
+			models.KeepConfiguredBundleTargetReferences(nil, model)
+			return model, diags
+		})
+	})
+}
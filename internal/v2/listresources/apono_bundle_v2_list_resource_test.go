@@ -0,0 +1,70 @@
+package listresources
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/mocks"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/resources"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/testcommon"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAponoBundleV2ListResource(t *testing.T) {
+	t.Run("List", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r := &AponoBundleV2ListResource{client: mockInvoker}
+
+		mockResponse := testcommon.GenerateBundleResponse()
+		params := client.ListBundlesV2Params{}
+		params.Name.SetTo("Test*")
+
+		mockInvoker.EXPECT().
+			ListBundlesV2(mock.Anything, params).
+			Return(&client.PublicApiListResponseBundlePublicV2Model{Items: []client.BundleV2{*mockResponse}}, nil)
+
+		config := models.NameListConfigModel{Name: types.StringValue("Test*")}
+		req := newTestListRequest(t, r, &resources.AponoBundleV2Resource{}, config, true)
+
+		results := collectResults(t, r, req)
+		require.Len(t, results, 1)
+		assert.Equal(t, mockResponse.Name, results[0].DisplayName)
+
+		identity := getResultIdentity(t, results[0])
+		assert.Equal(t, mockResponse.ID, identity.ID.ValueString())
+		assert.Equal(t, mockResponse.Name, identity.Name.ValueString())
+
+		expected, err := models.BundleResponseToModel(t.Context(), *mockResponse)
+		require.NoError(t, err)
+		models.KeepConfiguredBundleTargetReferences(nil, expected)
+
+		var got models.BundleV2Model
+		diags := results[0].Resource.Get(t.Context(), &got)
+		require.False(t, diags.HasError(), "Error getting resource: %s", diags.Errors())
+		assert.Equal(t, *expected, got)
+	})
+
+	t.Run("ListError", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r := &AponoBundleV2ListResource{client: mockInvoker}
+
+		mockInvoker.EXPECT().
+			ListBundlesV2(mock.Anything, mock.Anything).
+			Return(nil, assert.AnError)
+
+		req := newTestListRequest(t, r, &resources.AponoBundleV2Resource{}, models.NameListConfigModel{Name: types.StringNull()}, false)
+
+		stream := &list.ListResultsStream{}
+		r.List(t.Context(), req, stream)
+
+		results := slices.Collect(stream.Results)
+		require.Len(t, results, 1)
+		assert.True(t, results[0].Diagnostics.HasError())
+	})
+}
@@ -0,0 +1,82 @@
+package listresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/services"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResourceWithConfigure = &AponoManagedGroupListResource{}
+
+func NewAponoManagedGroupListResource() list.ListResource {
+	return &AponoManagedGroupListResource{}
+}
+
+type AponoManagedGroupListResource struct {
+	client client.Invoker
+}
+
+func (r *AponoManagedGroupListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_group"
+}
+
+func (r *AponoManagedGroupListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists existing Apono groups, optionally filtered by name.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: `Filter by group name. Partial matching is supported with asterisks for contains, starts with, and ends with. (e.g., "eng*"). Matching is case-insensitive.`,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *AponoManagedGroupListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	common.ConfigureResourceClientInvoker(ctx, req, resp, &r.client)
+}
+
+func (r *AponoManagedGroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config models.NameListConfigModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Listing groups", map[string]any{
+		"name_filter": config.Name.ValueString(),
+	})
+
+	groups, err := services.ListGroups(ctx, r.client, config.Name.ValueString())
+	if err != nil {
+		stream.Results = errorResults("Error listing groups", fmt.Sprintf("Could not list groups: %v", err))
+		return
+	}
+
+	stream.Results = streamResults(ctx, req, groups, func(group client.GroupV1) list.ListResult {
+		return newListResult(ctx, req, group.ID, group.Name, func() (any, diag.Diagnostics) {
+			var diags diag.Diagnostics
+
+			// Members are only fetched when the full resource is requested, since they require an API call per group
+			members, err := services.ListGroupMembers(ctx, r.client, group.ID)
+			if err != nil {
+				diags.AddError("Error reading group members", fmt.Sprintf("Could not read members for group ID %s: %v", group.ID, err))
+				return nil, diags
+			}
+
+			model := models.GroupToModel(&group)
+			model.Members, diags = models.GroupMembersToSet(ctx, members)
+			return model, diags
+		})
+	})
+}
@@ -0,0 +1,65 @@
+package listresources
+
+import (
+	"testing"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/mocks"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/resources"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/testcommon"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAponoManagedGroupListResource(t *testing.T) {
+	groups := &client.PublicApiListResponseGroupPublicV1Model{
+		Items: []client.GroupV1{{ID: "group-1", Name: "Engineering"}},
+	}
+
+	t.Run("List", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r := &AponoManagedGroupListResource{client: mockInvoker}
+
+		mockInvoker.EXPECT().ListGroupsV1(mock.Anything, mock.Anything).Return(groups, nil)
+		mockInvoker.EXPECT().
+			ListGroupMembersV1(mock.Anything, mock.MatchedBy(func(params client.ListGroupMembersV1Params) bool {
+				return params.ID == "group-1"
+			})).
+			Return(&client.PublicApiListResponseGroupMemberPublicV1Model{
+				Items: []client.GroupMemberV1{{Email: "user1@example.com"}, {Email: "user2@example.com"}},
+			}, nil)
+
+		req := newTestListRequest(t, r, &resources.AponoManagedGroupResource{}, models.NameListConfigModel{Name: types.StringNull()}, true)
+
+		results := collectResults(t, r, req)
+		require.Len(t, results, 1)
+
+		identity := getResultIdentity(t, results[0])
+		assert.Equal(t, "group-1", identity.ID.ValueString())
+		assert.Equal(t, "Engineering", identity.Name.ValueString())
+
+		var got models.GroupModel
+		diags := results[0].Resource.Get(t.Context(), &got)
+		require.False(t, diags.HasError(), "Error getting resource: %s", diags.Errors())
+		assert.Equal(t, "Engineering", got.Name.ValueString())
+		assert.Equal(t, testcommon.CreateTestStringSet(t, []string{"user1@example.com", "user2@example.com"}), got.Members)
+	})
+
+	t.Run("ListWithoutResource", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r := &AponoManagedGroupListResource{client: mockInvoker}
+
+		// Members must not be fetched when Terraform only asks for identities
+		mockInvoker.EXPECT().ListGroupsV1(mock.Anything, mock.Anything).Return(groups, nil)
+
+		req := newTestListRequest(t, r, &resources.AponoManagedGroupResource{}, models.NameListConfigModel{Name: types.StringNull()}, false)
+
+		results := collectResults(t, r, req)
+		require.Len(t, results, 1)
+		assert.Equal(t, "Engineering", results[0].DisplayName)
+		assert.True(t, results[0].Resource.Raw.IsNull())
+	})
+}
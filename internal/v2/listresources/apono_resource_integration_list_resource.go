@@ -0,0 +1,87 @@
+package listresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/services"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ list.ListResourceWithConfigure = &AponoResourceIntegrationListResource{}
+
+func NewAponoResourceIntegrationListResource() list.ListResource {
+	return &AponoResourceIntegrationListResource{}
+}
+
+type AponoResourceIntegrationListResource struct {
+	client client.Invoker
+}
+
+func (r *AponoResourceIntegrationListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_integration"
+}
+
+func (r *AponoResourceIntegrationListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists existing Apono resource integrations, optionally filtered by name, type and connector.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: `Filter by integration name. Partial matching is supported with asterisks for contains, starts with, and ends with. (e.g., "DB Prod*"). Matching is case-insensitive.`,
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: `Filter by Apono integration type. Partial matching is supported with asterisks for contains, starts with, and ends with. (e.g., "\*duty\*", "aws-*"). Matching is case-insensitive.`,
+				Optional:    true,
+			},
+			"connector_id": schema.StringAttribute{
+				Description: "Filter by the ID of the connector used to connect the integration. Matching is case-insensitive.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *AponoResourceIntegrationListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	common.ConfigureResourceClientInvoker(ctx, req, resp, &r.client)
+}
+
+func (r *AponoResourceIntegrationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config models.ResourceIntegrationsListConfigModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Listing resource integrations", map[string]any{
+		"name_filter":         config.Name.ValueString(),
+		"type_filter":         config.Type.ValueString(),
+		"connector_id_filter": config.ConnectorID.ValueString(),
+	})
+
+	integrations, err := services.ListIntegrations(ctx, r.client, config.Type.ValueString(), config.Name.ValueString(), config.ConnectorID.ValueString(), []string{common.ResourceCategory})
+	if err != nil {
+		stream.Results = errorResults("Error listing resource integrations", fmt.Sprintf("Could not list resource integrations: %v", err))
+		return
+	}
+
+	stream.Results = streamResults(ctx, req, integrations, func(integration client.IntegrationV4) list.ListResult {
+		return newListResult(ctx, req, integration.ID, integration.Name, func() (any, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			model, err := models.ResourceIntegrationToModel(ctx, &integration)
+			if err != nil {
+				diags.AddError("Error converting resource integration", fmt.Sprintf("Could not convert resource integration ID %s: %v", integration.ID, err))
+				return nil, diags
+			}
+			return models.NewResourceIntegrationResourceModel(model, nil), diags
+		})
+	})
+}
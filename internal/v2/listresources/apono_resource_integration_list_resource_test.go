@@ -0,0 +1,52 @@
+package listresources
+
+import (
+	"testing"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/mocks"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/resources"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/testcommon"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAponoResourceIntegrationListResource(t *testing.T) {
+	t.Run("List", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r := &AponoResourceIntegrationListResource{client: mockInvoker}
+
+		mockResponse := testcommon.GenerateResourceIntegrationResponse()
+		mockInvoker.EXPECT().
+			ListIntegrationsV4(mock.Anything, mock.MatchedBy(func(params client.ListIntegrationsV4Params) bool {
+				return assert.ObjectsAreEqual([]string{common.ResourceCategory}, params.Category.Value) &&
+					assert.ObjectsAreEqual([]string{"postgres"}, params.Type.Value)
+			})).
+			Return(&client.PublicApiListResponseIntegrationPublicV4Model{Items: []client.IntegrationV4{*mockResponse}}, nil)
+
+		config := models.ResourceIntegrationsListConfigModel{
+			Name:        types.StringNull(),
+			Type:        types.StringValue("postgres"),
+			ConnectorID: types.StringNull(),
+		}
+		req := newTestListRequest(t, r, &resources.AponoResourceIntegrationResource{}, config, true)
+
+		results := collectResults(t, r, req)
+		require.Len(t, results, 1)
+
+		identity := getResultIdentity(t, results[0])
+		assert.Equal(t, mockResponse.ID, identity.ID.ValueString())
+
+		expected, err := models.ResourceIntegrationToModel(t.Context(), mockResponse)
+		require.NoError(t, err)
+
+		var got models.ResourceIntegrationResourceModel
+		diags := results[0].Resource.Get(t.Context(), &got)
+		require.False(t, diags.HasError(), "Error getting resource: %s", diags.Errors())
+		assert.Equal(t, *models.NewResourceIntegrationResourceModel(expected, nil), got)
+	})
+}
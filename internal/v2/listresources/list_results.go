@@ -0,0 +1,58 @@
+package listresources
+
+import (
+	"context"
+	"iter"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// streamResults converts the listed items to list results, stopping once the requested limit is reached.
+func streamResults[T any](ctx context.Context, req list.ListRequest, items []T, toResult func(item T) list.ListResult) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			if !push(toResult(item)) {
+				return
+			}
+		}
+	}
+}
+
+// newListResult returns the result of a single listed object. The model is only converted when
+// Terraform asks for the full resource, e.g. to generate its configuration.
+func newListResult(ctx context.Context, req list.ListRequest, id, name string, toModel func() (any, diag.Diagnostics)) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = name
+
+	result.Diagnostics.Append(result.Identity.Set(ctx, common.ResourceIdentityModel{
+		ID:   types.StringValue(id),
+		Name: types.StringValue(name),
+	})...)
+
+	if !req.IncludeResource || result.Diagnostics.HasError() {
+		return result
+	}
+
+	model, diags := toModel()
+	result.Diagnostics.Append(diags...)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+
+	result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+	return result
+}
+
+// errorResults returns a stream with a single error result, used when the objects could not be listed.
+func errorResults(summary, detail string) iter.Seq[list.ListResult] {
+	var diags diag.Diagnostics
+	diags.AddError(summary, detail)
+	return list.ListResultsStreamDiagnostics(diags)
+}
@@ -0,0 +1,84 @@
+package listresources
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamResults(t *testing.T) {
+	ctx := t.Context()
+	req := list.ListRequest{
+		ResourceIdentitySchema: common.GetResourceIdentitySchema("item"),
+		Limit:                  2,
+	}
+
+	results := slices.Collect(streamResults(ctx, req, []string{"a", "b", "c"}, func(item string) list.ListResult {
+		return list.ListResult{DisplayName: item}
+	}))
+
+	require.Len(t, results, 2)
+	assert.Equal(t, "a", results[0].DisplayName)
+	assert.Equal(t, "b", results[1].DisplayName)
+}
+
+// newTestListRequest builds a list request for the list resource, using the schemas of its managed resource.
+func newTestListRequest(t *testing.T, listResource list.ListResource, managedResource resource.ResourceWithIdentity, config any, includeResource bool) list.ListRequest {
+	t.Helper()
+	ctx := t.Context()
+
+	var schemaResp list.ListResourceSchemaResponse
+	listResource.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResp)
+
+	configState := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := configState.Set(ctx, config)
+	require.False(t, diags.HasError(), "Error setting config: %s", diags.Errors())
+
+	var resourceSchemaResp resource.SchemaResponse
+	managedResource.Schema(ctx, resource.SchemaRequest{}, &resourceSchemaResp)
+
+	var identitySchemaResp resource.IdentitySchemaResponse
+	managedResource.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchemaResp)
+
+	return list.ListRequest{
+		Config:                 tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw},
+		IncludeResource:        includeResource,
+		ResourceSchema:         resourceSchemaResp.Schema,
+		ResourceIdentitySchema: identitySchemaResp.IdentitySchema,
+	}
+}
+
+// collectResults runs the list request and returns its results, failing on any error diagnostic.
+func collectResults(t *testing.T, listResource list.ListResource, req list.ListRequest) []list.ListResult {
+	t.Helper()
+
+	stream := &list.ListResultsStream{}
+	listResource.List(t.Context(), req, stream)
+
+	results := slices.Collect(stream.Results)
+	for _, result := range results {
+		require.False(t, result.Diagnostics.HasError(), "List returned error: %s", result.Diagnostics.Errors())
+	}
+
+	return results
+}
+
+func getResultIdentity(t *testing.T, result list.ListResult) common.ResourceIdentityModel {
+	t.Helper()
+
+	var identity common.ResourceIdentityModel
+	diags := result.Identity.Get(t.Context(), &identity)
+	require.False(t, diags.HasError(), "Error getting identity: %s", diags.Errors())
+
+	return identity
+}
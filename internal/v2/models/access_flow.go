@@ -1,6 +1,9 @@
 package models
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type AccessFlowV2Model struct {
 	ID                 types.String                  `tfsdk:"id"`
@@ -16,6 +19,18 @@ type AccessFlowV2Model struct {
 	RequestFor         *AccessFlowRequestForModel    `tfsdk:"request_for"`
 	AccessTargets      []AccessFlowAccessTargetModel `tfsdk:"access_targets"`
 	Settings           *AccessFlowSettingsModel      `tfsdk:"settings"`
+	ApproverCheck      types.String                  `tfsdk:"approver_check"`
+	EffectiveApprovers types.List                    `tfsdk:"effective_approvers"`
+}
+
+// EffectiveApproversTierType is the type of an effective_approvers element: the approvers of the approver policy
+// (tier 0) or of an escalation tier.
+var EffectiveApproversTierType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"tier":    types.Int32Type,
+		"users":   types.SetType{ElemType: types.StringType},
+		"dynamic": types.BoolType,
+	},
 }
 
 type AccessFlowTimeframeModel struct {
@@ -58,12 +73,14 @@ type AccessFlowSettingsModel struct {
 	RequesterCannotApproveSelf types.Bool  `tfsdk:"requester_cannot_approve_self"`
 	RequireMFA                 types.Bool  `tfsdk:"require_mfa"`
 	Labels                     types.Set   `tfsdk:"labels"`
+	LabelsAll                  types.Set   `tfsdk:"labels_all"`
 	MaxExtensions              types.Int32 `tfsdk:"max_extensions"`
 	ExtensionDurationInMin     types.Int32 `tfsdk:"extension_duration_in_min"`
 }
 
 type AccessFlowTargetBundleModel struct {
 	Name types.String `tfsdk:"name"`
+	ID   types.String `tfsdk:"bundle_id"`
 }
 
 type AccessFlowAccessTargetModel struct {
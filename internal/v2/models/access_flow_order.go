@@ -0,0 +1,110 @@
+package models
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// PreserveAccessFlowOrder reorders the conditions, approvers, values, access targets and resources scopes of an
+// access flow converted from the API to follow their order in the prior model, i.e. the plan or the state, when they
+// hold the same elements. The order of these lists carries no meaning, so the API returning them in a different order
+// must not show up as a diff. Escalation approver groups are tiers and are never reordered. Target references must be
+// reconciled with KeepConfiguredAccessFlowTargetReferences first.
+func PreserveAccessFlowOrder(ctx context.Context, prior, current *AccessFlowV2Model) {
+	if prior == nil || current == nil {
+		return
+	}
+
+	if prior.Requestors != nil && current.Requestors != nil {
+		current.Requestors.Conditions = preserveConditionsOrder(ctx, prior.Requestors.Conditions, current.Requestors.Conditions)
+	}
+
+	if prior.RequestFor != nil && current.RequestFor != nil && prior.RequestFor.Grantees != nil && current.RequestFor.Grantees != nil {
+		current.RequestFor.Grantees.Conditions = preserveConditionsOrder(ctx, prior.RequestFor.Grantees.Conditions, current.RequestFor.Grantees.Conditions)
+	}
+
+	if prior.ApproverPolicy != nil && current.ApproverPolicy != nil {
+		current.ApproverPolicy.ApproverGroups = common.PreserveOrder(prior.ApproverPolicy.ApproverGroups, current.ApproverPolicy.ApproverGroups, approverGroupKey,
+			func(prior, current AccessFlowApproverGroup) AccessFlowApproverGroup {
+				current.Approvers = preserveConditionsOrder(ctx, prior.Approvers, current.Approvers)
+				return current
+			})
+	}
+
+	if prior.EscalationPolicy != nil && current.EscalationPolicy != nil && len(prior.EscalationPolicy.ApproverGroups) == len(current.EscalationPolicy.ApproverGroups) {
+		for i, group := range current.EscalationPolicy.ApproverGroups {
+			priorGroup := prior.EscalationPolicy.ApproverGroups[i]
+			if approverGroupKey(priorGroup) == approverGroupKey(group) {
+				current.EscalationPolicy.ApproverGroups[i].Approvers = preserveConditionsOrder(ctx, priorGroup.Approvers, group.Approvers)
+			}
+		}
+	}
+
+	current.AccessTargets = common.PreserveOrder(prior.AccessTargets, current.AccessTargets, accessTargetKey,
+		func(prior, current AccessFlowAccessTargetModel) AccessFlowAccessTargetModel {
+			if prior.Integration != nil && current.Integration != nil {
+				current.Integration.ResourcesScopes = common.PreserveOrder(prior.Integration.ResourcesScopes, current.Integration.ResourcesScopes, scopeKey,
+					func(prior, current IntegrationTargetScopeModel) IntegrationTargetScopeModel {
+						current.Values = common.PreserveListOrder(ctx, prior.Values, current.Values)
+						return current
+					})
+			}
+			return current
+		})
+}
+
+func preserveConditionsOrder(ctx context.Context, prior, current []AccessFlowCondition) []AccessFlowCondition {
+	return common.PreserveOrder(prior, current, conditionKey, func(prior, current AccessFlowCondition) AccessFlowCondition {
+		current.Values = common.PreserveListOrder(ctx, prior.Values, current.Values)
+		return current
+	})
+}
+
+// The keys below identify an element regardless of the order of its nested lists.
+
+func conditionKey(condition AccessFlowCondition) string {
+	return joinKey(condition.SourceIntegrationName.String(), condition.Type.String(), condition.MatchOperator.String(), elementsKey(condition.Values.Elements()))
+}
+
+func approverGroupKey(group AccessFlowApproverGroup) string {
+	return joinKey(group.LogicalOperator.String(), sortedKey(group.Approvers, conditionKey))
+}
+
+func accessTargetKey(target AccessFlowAccessTargetModel) string {
+	switch {
+	case target.Integration != nil:
+		return joinKey("integration", target.Integration.IntegrationName.String(), target.Integration.IntegrationID.String(), target.Integration.ResourceType.String(),
+			elementsKey(target.Integration.Permissions.Elements()), sortedKey(target.Integration.ResourcesScopes, scopeKey))
+	case target.Bundle != nil:
+		return joinKey("bundle", target.Bundle.Name.String(), target.Bundle.ID.String())
+	case target.AccessScope != nil:
+		return joinKey("access_scope", target.AccessScope.Name.String(), target.AccessScope.ID.String())
+	default:
+		return ""
+	}
+}
+
+func scopeKey(scope IntegrationTargetScopeModel) string {
+	return joinKey(scope.ScopeMode.String(), scope.Type.String(), scope.Key.String(), elementsKey(scope.Values.Elements()))
+}
+
+func elementsKey(elements []attr.Value) string {
+	return sortedKey(elements, attr.Value.String)
+}
+
+func sortedKey[T any](elements []T, key func(T) string) string {
+	keys := make([]string, len(elements))
+	for i, element := range elements {
+		keys[i] = key(element)
+	}
+	slices.Sort(keys)
+	return "[" + joinKey(keys...) + "]"
+}
+
+func joinKey(parts ...string) string {
+	return strings.Join(parts, "\x00")
+}
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testStringList(values ...string) types.List {
+	elements := make([]attr.Value, len(values))
+	for i, value := range values {
+		elements[i] = types.StringValue(value)
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func testCondition(conditionType string, values ...string) AccessFlowCondition {
+	return AccessFlowCondition{
+		SourceIntegrationName: types.StringNull(),
+		Type:                  types.StringValue(conditionType),
+		MatchOperator:         types.StringValue("is"),
+		Values:                testStringList(values...),
+	}
+}
+
+func TestPreserveAccessFlowOrder(t *testing.T) {
+	ctx := t.Context()
+
+	newModel := func(requestors []AccessFlowCondition, approvers []AccessFlowCondition, escalation []AccessFlowApproverGroup, scopeValues types.List, targets ...AccessFlowAccessTargetModel) *AccessFlowV2Model {
+		integration := AccessFlowAccessTargetModel{Integration: &IntegrationTargetModel{
+			IntegrationName: types.StringValue("postgres"),
+			ResourceType:    types.StringValue("postgresql-database"),
+			Permissions:     types.SetValueMust(types.StringType, []attr.Value{types.StringValue("READ_ONLY")}),
+			ResourcesScopes: []IntegrationTargetScopeModel{{
+				ScopeMode: types.StringValue("include_resources"),
+				Type:      types.StringValue("NAME"),
+				Key:       types.StringNull(),
+				Values:    scopeValues,
+			}},
+		}}
+
+		return &AccessFlowV2Model{
+			Requestors:       &AccessFlowRequestorsModel{LogicalOperator: types.StringValue("OR"), Conditions: requestors},
+			ApproverPolicy:   &AccessFlowApproverPolicy{ApprovalMode: types.StringValue("ANY_OF"), ApproverGroups: []AccessFlowApproverGroup{{LogicalOperator: types.StringValue("OR"), Approvers: approvers}}},
+			EscalationPolicy: &EscalationPolicyModel{IntervalInMin: types.Int32Value(30), ApproverGroups: escalation},
+			AccessTargets:    append([]AccessFlowAccessTargetModel{integration}, targets...),
+		}
+	}
+
+	bundle := AccessFlowAccessTargetModel{Bundle: &AccessFlowTargetBundleModel{Name: types.StringValue("bundle")}}
+	accessScope := AccessFlowAccessTargetModel{AccessScope: &AccessScopeTargetModel{Name: types.StringValue("scope")}}
+
+	t.Run("Reordered", func(t *testing.T) {
+		prior := newModel(
+			[]AccessFlowCondition{testCondition("user", "a@example.com", "b@example.com"), testCondition("group", "admins")},
+			[]AccessFlowCondition{testCondition("manager"), testCondition("user", "c@example.com", "d@example.com")},
+			[]AccessFlowApproverGroup{
+				{LogicalOperator: types.StringValue("OR"), Approvers: []AccessFlowCondition{testCondition("user", "e@example.com", "f@example.com")}},
+				{LogicalOperator: types.StringValue("OR"), Approvers: []AccessFlowCondition{testCondition("group", "security")}},
+			},
+			testStringList("db1", "db2"),
+			bundle, accessScope,
+		)
+		current := newModel(
+			[]AccessFlowCondition{testCondition("group", "admins"), testCondition("user", "b@example.com", "a@example.com")},
+			[]AccessFlowCondition{testCondition("user", "d@example.com", "c@example.com"), testCondition("manager")},
+			[]AccessFlowApproverGroup{
+				{LogicalOperator: types.StringValue("OR"), Approvers: []AccessFlowCondition{testCondition("user", "f@example.com", "e@example.com")}},
+				{LogicalOperator: types.StringValue("OR"), Approvers: []AccessFlowCondition{testCondition("group", "security")}},
+			},
+			testStringList("db2", "db1"),
+			accessScope, bundle,
+		)
+
+		PreserveAccessFlowOrder(ctx, prior, current)
+
+		assert.Equal(t, prior, current)
+	})
+
+	t.Run("EscalationTiersAreNotReordered", func(t *testing.T) {
+		tiers := []AccessFlowApproverGroup{
+			{LogicalOperator: types.StringValue("OR"), Approvers: []AccessFlowCondition{testCondition("manager")}},
+			{LogicalOperator: types.StringValue("OR"), Approvers: []AccessFlowCondition{testCondition("group", "security")}},
+		}
+		prior := newModel(nil, nil, tiers, testStringList("db1"))
+		current := newModel(nil, nil, []AccessFlowApproverGroup{tiers[1], tiers[0]}, testStringList("db1"))
+
+		PreserveAccessFlowOrder(ctx, prior, current)
+
+		assert.Equal(t, []AccessFlowApproverGroup{tiers[1], tiers[0]}, current.EscalationPolicy.ApproverGroups)
+	})
+
+	t.Run("ChangedElementsAreNotReordered", func(t *testing.T) {
+		prior := newModel([]AccessFlowCondition{testCondition("user", "a@example.com"), testCondition("group", "admins")}, nil, nil, testStringList("db1"))
+		current := newModel([]AccessFlowCondition{testCondition("group", "developers"), testCondition("user", "a@example.com")}, nil, nil, testStringList("db1"))
+
+		PreserveAccessFlowOrder(ctx, prior, current)
+
+		assert.Equal(t, []AccessFlowCondition{testCondition("group", "developers"), testCondition("user", "a@example.com")}, current.Requestors.Conditions)
+	})
+}
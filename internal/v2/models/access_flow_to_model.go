@@ -3,6 +3,7 @@ package models
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
@@ -10,12 +11,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
-func AccessFlowResponseToModel(ctx context.Context, response client.AccessFlowV2) (*AccessFlowV2Model, error) {
+// AccessFlowResponseToModel converts the API response to a model. Labels matching the provider default labels
+// are reported only in settings.labels_all, so they don't show up as drift in settings.labels.
+func AccessFlowResponseToModel(ctx context.Context, response client.AccessFlowV2, defaultLabels []string) (*AccessFlowV2Model, error) {
 	model := AccessFlowV2Model{
-		ID:      types.StringValue(response.ID),
-		Name:    types.StringValue(response.Name),
-		Active:  types.BoolValue(response.Active),
-		Trigger: types.StringValue(response.Trigger),
+		ID:                 types.StringValue(response.ID),
+		Name:               types.StringValue(response.Name),
+		Active:             types.BoolValue(response.Active),
+		Trigger:            types.StringValue(response.Trigger),
+		EffectiveApprovers: types.ListNull(EffectiveApproversTierType),
 	}
 
 	if val, ok := response.Description.Get(); ok {
@@ -34,7 +38,7 @@ func AccessFlowResponseToModel(ctx context.Context, response client.AccessFlowV2
 		model.Timeframe = timeframe
 	}
 
-	settings, err := convertSettingsToModel(ctx, response.Settings)
+	settings, err := convertSettingsToModel(ctx, response.Settings, defaultLabels)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert settings: %w", err)
 	}
@@ -82,7 +86,7 @@ func AccessFlowResponseToModel(ctx context.Context, response client.AccessFlowV2
 func convertTimeframeToModel(ctx context.Context, timeframe client.AccessFlowTimeframeV2) (*AccessFlowTimeframeModel, error) {
 	daysOfWeek := []string{}
 	for _, day := range timeframe.DaysOfWeek {
-		daysOfWeek = append(daysOfWeek, string(day))
+		daysOfWeek = append(daysOfWeek, strings.ToUpper(string(day)))
 	}
 
 	daysOfWeekSet, diags := types.SetValueFrom(ctx, types.StringType, daysOfWeek)
@@ -91,8 +95,8 @@ func convertTimeframeToModel(ctx context.Context, timeframe client.AccessFlowTim
 	}
 
 	return &AccessFlowTimeframeModel{
-		StartTime:  types.StringValue(timeframe.StartTime),
-		EndTime:    types.StringValue(timeframe.EndTime),
+		StartTime:  types.StringValue(common.NormalizeTimeOfDay(timeframe.StartTime)),
+		EndTime:    types.StringValue(common.NormalizeTimeOfDay(timeframe.EndTime)),
 		DaysOfWeek: daysOfWeekSet,
 		TimeZone:   types.StringValue(timeframe.TimeZone),
 	}, nil
@@ -214,12 +218,14 @@ func convertAccessTargetsToModel(ctx context.Context, accessTargets []client.Acc
 		if val, ok := target.Bundle.Get(); ok {
 			modelTarget.Bundle = &AccessFlowTargetBundleModel{
 				Name: types.StringValue(val.BundleName),
+				ID:   types.StringValue(val.BundleID),
 			}
 		}
 
 		if val, ok := target.AccessScope.Get(); ok {
 			modelTarget.AccessScope = &AccessScopeTargetModel{
 				Name: types.StringValue(val.AccessScopeName),
+				ID:   types.StringValue(val.AccessScopeID),
 			}
 		}
 
@@ -232,6 +238,7 @@ func convertAccessTargetsToModel(ctx context.Context, accessTargets []client.Acc
 func convertIntegrationTargetToModel(ctx context.Context, integration client.IntegrationAccessTargetV2) (*IntegrationTargetModel, error) {
 	model := &IntegrationTargetModel{
 		IntegrationName: types.StringValue(integration.IntegrationName),
+		IntegrationID:   types.StringValue(integration.IntegrationID),
 		ResourceType:    types.StringValue(integration.ResourceType),
 	}
 
@@ -277,7 +284,7 @@ func convertResourcesScopesToModel(ctx context.Context, scopes []client.Resource
 	return modelScopes, nil
 }
 
-func convertSettingsToModel(ctx context.Context, settings client.AccessFlowSettingsV2) (*AccessFlowSettingsModel, error) {
+func convertSettingsToModel(ctx context.Context, settings client.AccessFlowSettingsV2, defaultLabels []string) (*AccessFlowSettingsModel, error) {
 	model := &AccessFlowSettingsModel{
 		JustificationRequired:      types.BoolValue(settings.JustificationRequired),
 		RequireApproverReason:      types.BoolValue(settings.RequireApproverReason),
@@ -285,8 +292,9 @@ func convertSettingsToModel(ctx context.Context, settings client.AccessFlowSetti
 		RequireMFA:                 types.BoolValue(settings.RequireMfa),
 	}
 
-	if len(settings.Labels) > 0 {
-		labelsSet, diags := types.SetValueFrom(ctx, types.StringType, settings.Labels)
+	resourceLabels := excludeLabels(settings.Labels, defaultLabels)
+	if len(resourceLabels) > 0 {
+		labelsSet, diags := types.SetValueFrom(ctx, types.StringType, resourceLabels)
 		if diags.HasError() {
 			return nil, fmt.Errorf("failed to convert labels: %v", diags)
 		}
@@ -295,6 +303,12 @@ func convertSettingsToModel(ctx context.Context, settings client.AccessFlowSetti
 		model.Labels = basetypes.NewSetNull(types.StringType)
 	}
 
+	labelsAll, diags := types.SetValueFrom(ctx, types.StringType, MergeLabels(settings.Labels, nil))
+	if diags.HasError() {
+		return nil, fmt.Errorf("failed to convert labels_all: %v", diags)
+	}
+	model.LabelsAll = labelsAll
+
 	if val, ok := settings.MaxExtensions.Get(); ok {
 		model.MaxExtensions = types.Int32Value(val)
 	}
@@ -343,3 +357,19 @@ func convertGranteesToModel(ctx context.Context, grantees client.GranteesV2) (*A
 
 	return model, nil
 }
+
+func excludeLabels(labels []string, excluded []string) []string {
+	excludedSet := make(map[string]bool, len(excluded))
+	for _, label := range excluded {
+		excludedSet[label] = true
+	}
+
+	result := []string{}
+	for _, label := range labels {
+		if !excludedSet[label] {
+			result = append(result, label)
+		}
+	}
+
+	return result
+}
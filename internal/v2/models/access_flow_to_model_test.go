@@ -130,7 +130,7 @@ func TestAccessFlowResponseToModel(t *testing.T) {
 	response.EscalationPolicy.SetTo(escalationPolicy)
 
 	ctx := t.Context()
-	model, err := AccessFlowResponseToModel(ctx, response)
+	model, err := AccessFlowResponseToModel(ctx, response, nil)
 	require.NoError(t, err)
 	require.NotNil(t, model)
 
@@ -279,7 +279,7 @@ func TestAccessFlowResponseToModelMinimalFields(t *testing.T) {
 	response.AccessTargets = []client.AccessTargetV2{bundleTarget}
 
 	ctx := t.Context()
-	model, err := AccessFlowResponseToModel(ctx, response)
+	model, err := AccessFlowResponseToModel(ctx, response, nil)
 	require.NoError(t, err)
 	require.NotNil(t, model)
 
@@ -317,3 +317,58 @@ func TestAccessFlowResponseToModelMinimalFields(t *testing.T) {
 
 	assert.Nil(t, model.RequestFor)
 }
+
+func TestAccessFlowResponseToModelDefaultLabels(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("splits default labels from resource labels", func(t *testing.T) {
+		settings, err := convertSettingsToModel(ctx, client.AccessFlowSettingsV2{
+			Labels: []string{"team:security", "env:prod"},
+		}, []string{"env:prod", "cost-center:123"})
+		require.NoError(t, err)
+
+		var labels []string
+		require.False(t, settings.Labels.ElementsAs(ctx, &labels, false).HasError())
+		assert.ElementsMatch(t, []string{"team:security"}, labels)
+
+		var labelsAll []string
+		require.False(t, settings.LabelsAll.ElementsAs(ctx, &labelsAll, false).HasError())
+		assert.ElementsMatch(t, []string{"team:security", "env:prod"}, labelsAll)
+	})
+
+	t.Run("only default labels", func(t *testing.T) {
+		settings, err := convertSettingsToModel(ctx, client.AccessFlowSettingsV2{
+			Labels: []string{"env:prod"},
+		}, []string{"env:prod"})
+		require.NoError(t, err)
+
+		assert.True(t, settings.Labels.IsNull())
+		assert.Len(t, settings.LabelsAll.Elements(), 1)
+	})
+
+	t.Run("no labels", func(t *testing.T) {
+		settings, err := convertSettingsToModel(ctx, client.AccessFlowSettingsV2{}, nil)
+		require.NoError(t, err)
+
+		assert.True(t, settings.Labels.IsNull())
+		assert.False(t, settings.LabelsAll.IsNull())
+		assert.Empty(t, settings.LabelsAll.Elements())
+	})
+}
+
+func TestConvertTimeframeToModelNormalizesTimes(t *testing.T) {
+	model, err := convertTimeframeToModel(t.Context(), client.AccessFlowTimeframeV2{
+		StartTime:  "08:00:00",
+		EndTime:    "17:30:00",
+		DaysOfWeek: []client.DayOfWeekV2{"monday", client.DayOfWeekV2FRIDAY},
+		TimeZone:   "UTC",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "08:00", model.StartTime.ValueString())
+	assert.Equal(t, "17:30", model.EndTime.ValueString())
+
+	var daysOfWeek []string
+	require.False(t, model.DaysOfWeek.ElementsAs(t.Context(), &daysOfWeek, false).HasError())
+	assert.ElementsMatch(t, []string{"MONDAY", "FRIDAY"}, daysOfWeek)
+}
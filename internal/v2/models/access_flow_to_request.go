@@ -7,7 +7,8 @@ import (
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
 )
 
-func AccessFlowModelToUpsertRequest(ctx context.Context, model AccessFlowV2Model) (*client.AccessFlowUpsertV2, error) {
+// AccessFlowModelToUpsertRequest converts the model to an API request, merging the provider default labels into the flow labels.
+func AccessFlowModelToUpsertRequest(ctx context.Context, model AccessFlowV2Model, defaultLabels []string) (*client.AccessFlowUpsertV2, error) {
 	upsert := client.AccessFlowUpsertV2{
 		Name:    model.Name.ValueString(),
 		Active:  model.Active.ValueBool(),
@@ -32,7 +33,7 @@ func AccessFlowModelToUpsertRequest(ctx context.Context, model AccessFlowV2Model
 	}
 
 	if model.Settings != nil {
-		settings, err := convertSettingsToUpsertRequest(ctx, *model.Settings)
+		settings, err := convertSettingsToUpsertRequest(ctx, *model.Settings, defaultLabels)
 		if err != nil {
 			return nil, fmt.Errorf("failed to convert settings: %w", err)
 		}
@@ -98,7 +99,11 @@ func convertTimeframeToUpsertRequest(ctx context.Context, model AccessFlowTimefr
 
 	daysOfWeek := []client.DayOfWeekV2{}
 	for _, dayStr := range daysOfWeekStrings {
-		daysOfWeek = append(daysOfWeek, client.DayOfWeekV2(dayStr))
+		day := client.DayOfWeekV2(dayStr)
+		if err := day.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid days_of_week value %q: %w", dayStr, err)
+		}
+		daysOfWeek = append(daysOfWeek, day)
 	}
 
 	timeframe.DaysOfWeek = daysOfWeek
@@ -216,7 +221,7 @@ func convertAccessTargetsToUpsertRequest(ctx context.Context, models []AccessFlo
 
 		if model.Bundle != nil {
 			bundle := client.BundleAccessTargetUpsertV2{
-				BundleReference: model.Bundle.Name.ValueString(),
+				BundleReference: targetReference(model.Bundle.Name, model.Bundle.ID),
 			}
 
 			target.Bundle.SetTo(bundle)
@@ -227,7 +232,7 @@ func convertAccessTargetsToUpsertRequest(ctx context.Context, models []AccessFlo
 
 		if model.AccessScope != nil {
 			scope := client.AccessScopeAccessTargetUpsertV2{
-				AccessScopeReference: model.AccessScope.Name.ValueString(),
+				AccessScopeReference: targetReference(model.AccessScope.Name, model.AccessScope.ID),
 			}
 
 			target.AccessScope.SetTo(scope)
@@ -247,7 +252,7 @@ func convertAccessTargetsToUpsertRequest(ctx context.Context, models []AccessFlo
 
 func convertIntegrationTargetToUpsertRequest(ctx context.Context, model IntegrationTargetModel) (*client.IntegrationAccessTargetUpsertV2, error) {
 	integration := client.IntegrationAccessTargetUpsertV2{
-		IntegrationReference: model.IntegrationName.ValueString(),
+		IntegrationReference: targetReference(model.IntegrationName, model.IntegrationID),
 		ResourceType:         model.ResourceType.ValueString(),
 	}
 
@@ -315,7 +320,7 @@ func convertEscalationPolicyToUpsertRequest(ctx context.Context, model Escalatio
 	return &policy, nil
 }
 
-func convertSettingsToUpsertRequest(ctx context.Context, model AccessFlowSettingsModel) (*client.AccessFlowSettingsV2, error) {
+func convertSettingsToUpsertRequest(ctx context.Context, model AccessFlowSettingsModel, defaultLabels []string) (*client.AccessFlowSettingsV2, error) {
 	settings := client.AccessFlowSettingsV2{
 		JustificationRequired:         model.JustificationRequired.ValueBool(),
 		RequireApproverReason:         model.RequireApproverReason.ValueBool(),
@@ -323,18 +328,15 @@ func convertSettingsToUpsertRequest(ctx context.Context, model AccessFlowSetting
 		RequireMfa:                    model.RequireMFA.ValueBool(),
 	}
 
+	labels := []string{}
 	if !model.Labels.IsNull() {
-		var labels []string
-
 		if diags := model.Labels.ElementsAs(ctx, &labels, false); diags.HasError() {
 			return nil, fmt.Errorf("failed to convert labels: %v", diags)
 		}
-
-		settings.Labels = labels
-	} else {
-		settings.Labels = []string{}
 	}
 
+	settings.Labels = MergeLabels(labels, defaultLabels)
+
 	settings.MaxExtensions.SetTo(model.MaxExtensions.ValueInt32())
 	settings.ExtensionDurationInMin.SetTo(model.ExtensionDurationInMin.ValueInt32())
 
@@ -378,3 +380,20 @@ func convertGranteesToUpsertRequest(ctx context.Context, model AccessFlowGrantee
 
 	return &grantees, nil
 }
+
+// MergeLabels returns the resource labels followed by any default labels not already present.
+func MergeLabels(labels []string, defaultLabels []string) []string {
+	merged := make([]string, 0, len(labels)+len(defaultLabels))
+	seen := make(map[string]bool, len(labels)+len(defaultLabels))
+
+	for _, label := range append(append([]string{}, labels...), defaultLabels...) {
+		if seen[label] {
+			continue
+		}
+
+		seen[label] = true
+		merged = append(merged, label)
+	}
+
+	return merged
+}
@@ -112,7 +112,7 @@ func TestAccessFlowV2ModelToUpsertRequest(t *testing.T) {
 	}
 
 	ctx := t.Context()
-	result, err := AccessFlowModelToUpsertRequest(ctx, model)
+	result, err := AccessFlowModelToUpsertRequest(ctx, model, nil)
 	require.NoError(t, err)
 	require.NotNil(t, result)
 
@@ -252,7 +252,7 @@ func TestAccessFlowV2ModelToUpsertRequestNullValues(t *testing.T) {
 	}
 
 	ctx := t.Context()
-	result, err := AccessFlowModelToUpsertRequest(ctx, model)
+	result, err := AccessFlowModelToUpsertRequest(ctx, model, nil)
 	require.NoError(t, err)
 	require.NotNil(t, result)
 
@@ -337,7 +337,7 @@ func TestAccessFlowV2ModelToUpsertRequestEscalationPolicyDefaultInterval(t *test
 	}
 
 	ctx := t.Context()
-	result, err := AccessFlowModelToUpsertRequest(ctx, model)
+	result, err := AccessFlowModelToUpsertRequest(ctx, model, nil)
 	require.NoError(t, err)
 	require.NotNil(t, result)
 
@@ -354,3 +354,56 @@ func TestAccessFlowV2ModelToUpsertRequestEscalationPolicyDefaultInterval(t *test
 	require.Len(t, escalationPolicy.ApproverGroups[0].Approvers, 1)
 	assert.Equal(t, "user", escalationPolicy.ApproverGroups[0].Approvers[0].Type)
 }
+
+func TestAccessFlowV2ModelToUpsertRequestDefaultLabels(t *testing.T) {
+	tests := []struct {
+		name          string
+		labels        types.Set
+		defaultLabels []string
+		expected      []string
+	}{
+		{
+			name:          "merges default labels",
+			labels:        testcommon.CreateTestStringSet(t, []string{"team:security"}),
+			defaultLabels: []string{"env:prod", "cost-center:123"},
+			expected:      []string{"team:security", "env:prod", "cost-center:123"},
+		},
+		{
+			name:          "null labels",
+			labels:        types.SetNull(types.StringType),
+			defaultLabels: []string{"env:prod"},
+			expected:      []string{"env:prod"},
+		},
+		{
+			name:          "duplicate labels",
+			labels:        testcommon.CreateTestStringSet(t, []string{"env:prod"}),
+			defaultLabels: []string{"env:prod"},
+			expected:      []string{"env:prod"},
+		},
+		{
+			name:     "no default labels",
+			labels:   types.SetNull(types.StringType),
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings, err := convertSettingsToUpsertRequest(t.Context(), AccessFlowSettingsModel{Labels: tt.labels}, tt.defaultLabels)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.expected, settings.Labels)
+			assert.NotNil(t, settings.Labels)
+		})
+	}
+}
+
+func TestConvertTimeframeToUpsertRequestInvalidDay(t *testing.T) {
+	_, err := convertTimeframeToUpsertRequest(t.Context(), AccessFlowTimeframeModel{
+		StartTime:  types.StringValue("08:00"),
+		EndTime:    types.StringValue("17:00"),
+		TimeZone:   types.StringValue("UTC"),
+		DaysOfWeek: testcommon.CreateTestStringSet(t, []string{"MONDAY", "Funday"}),
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Funday")
+}
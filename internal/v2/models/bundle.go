@@ -10,6 +10,7 @@ import (
 
 type IntegrationTargetModel struct {
 	IntegrationName types.String                  `tfsdk:"integration_name"`
+	IntegrationID   types.String                  `tfsdk:"integration_id"`
 	ResourceType    types.String                  `tfsdk:"resource_type"`
 	Permissions     types.Set                     `tfsdk:"permissions"`
 	ResourcesScopes []IntegrationTargetScopeModel `tfsdk:"resources_scopes"`
@@ -24,6 +25,7 @@ type IntegrationTargetScopeModel struct {
 
 type AccessScopeTargetModel struct {
 	Name types.String `tfsdk:"name"`
+	ID   types.String `tfsdk:"access_scope_id"`
 }
 
 type BundleAccessTargetModel struct {
@@ -104,6 +106,7 @@ func convertBundleAccessTargetsToModel(ctx context.Context, accessTargets []clie
 		if val, ok := target.AccessScope.Get(); ok {
 			modelTarget.AccessScope = &AccessScopeTargetModel{
 				Name: types.StringValue(val.AccessScopeName),
+				ID:   types.StringValue(val.AccessScopeID),
 			}
 		}
 
@@ -132,7 +135,7 @@ func convertBundleAccessTargetsToUpsertRequest(ctx context.Context, models []Bun
 
 		if model.AccessScope != nil {
 			scope := client.AccessScopeAccessTargetUpsertV2{
-				AccessScopeReference: model.AccessScope.Name.ValueString(),
+				AccessScopeReference: targetReference(model.AccessScope.Name, model.AccessScope.ID),
 			}
 
 			target.AccessScope.SetTo(scope)
@@ -54,6 +54,7 @@ func TestBundleConversions(t *testing.T) {
 
 		require.NotNil(t, model.AccessTargets[0].Integration)
 		assert.Equal(t, "postgresql", model.AccessTargets[0].Integration.IntegrationName.ValueString())
+		assert.Equal(t, "integration-123", model.AccessTargets[0].Integration.IntegrationID.ValueString())
 		assert.Equal(t, "database", model.AccessTargets[0].Integration.ResourceType.ValueString())
 
 		var permissions []string
@@ -72,6 +73,7 @@ func TestBundleConversions(t *testing.T) {
 
 		require.NotNil(t, model.AccessTargets[1].AccessScope)
 		assert.Equal(t, "Test Scope", model.AccessTargets[1].AccessScope.Name.ValueString())
+		assert.Equal(t, "scope-123", model.AccessTargets[1].AccessScope.ID.ValueString())
 	})
 
 	t.Run("BundleModelToUpsertRequest", func(t *testing.T) {
@@ -1,14 +1,28 @@
 package models
 
 import (
+	"context"
+
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type GroupModel struct {
-	ID      types.String `tfsdk:"id"`
-	Name    types.String `tfsdk:"name"`
-	Members types.Set    `tfsdk:"members"`
+	ID          types.String           `tfsdk:"id"`
+	Name        types.String           `tfsdk:"name"`
+	Members     types.Set              `tfsdk:"members"`
+	MemberRules []GroupMemberRuleModel `tfsdk:"member_rules"`
+	MemberCheck types.String           `tfsdk:"member_check"`
+}
+
+// GroupMemberRuleModel is a condition on Apono users. A managed group with member rules has the active users that
+// match all of its rules as members.
+type GroupMemberRuleModel struct {
+	Type          types.String `tfsdk:"type"`
+	AttributeName types.String `tfsdk:"attribute_name"`
+	MatchOperator types.String `tfsdk:"match_operator"`
+	Values        types.List   `tfsdk:"values"`
 }
 
 type GroupDataModel struct {
@@ -32,6 +46,35 @@ func GroupToModel(group *client.GroupV1) GroupModel {
 	}
 }
 
+func GroupMembersToSet(ctx context.Context, members []client.GroupMemberV1) (types.Set, diag.Diagnostics) {
+	memberEmails := []string{}
+	for _, member := range members {
+		memberEmails = append(memberEmails, member.Email)
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, memberEmails)
+}
+
+// ReconcileGroupMembers converts the member emails returned by the API to a set. Members that match a prior member by
+// canonicalEmail, e.g. the same email in a different case or the primary email of a configured alias, keep their
+// prior value so they don't cause a diff.
+func ReconcileGroupMembers(ctx context.Context, members []string, prior []string, canonicalEmail func(string) string) (types.Set, diag.Diagnostics) {
+	priorByCanonical := make(map[string]string, len(prior))
+	for _, email := range prior {
+		priorByCanonical[canonicalEmail(email)] = email
+	}
+
+	memberEmails := make([]string, 0, len(members))
+	for _, email := range members {
+		if priorEmail, ok := priorByCanonical[canonicalEmail(email)]; ok {
+			email = priorEmail
+		}
+		memberEmails = append(memberEmails, email)
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, memberEmails)
+}
+
 func GroupToDataModel(group *client.GroupV1) GroupDataModel {
 	model := GroupDataModel{
 		ID:   types.StringValue(group.ID),
@@ -1,11 +1,13 @@
 package models
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGroupToDataModel(t *testing.T) {
@@ -75,3 +77,27 @@ func TestGroupToDataModel(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileGroupMembers(t *testing.T) {
+	ctx := t.Context()
+	aliases := map[string]string{"jane.doe@example.com": "jane@example.com"}
+	canonicalEmail := func(email string) string {
+		email = strings.ToLower(email)
+		if primary, ok := aliases[email]; ok {
+			return primary
+		}
+		return email
+	}
+
+	members, diags := ReconcileGroupMembers(
+		ctx,
+		[]string{"john@example.com", "jane@example.com", "new@example.com"},
+		[]string{"John@Example.com", "Jane.Doe@example.com", "removed@example.com"},
+		canonicalEmail,
+	)
+	require.False(t, diags.HasError())
+
+	var emails []string
+	require.False(t, members.ElementsAs(ctx, &emails, false).HasError())
+	assert.ElementsMatch(t, []string{"John@Example.com", "Jane.Doe@example.com", "new@example.com"}, emails)
+}
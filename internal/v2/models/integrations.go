@@ -3,6 +3,7 @@ package models
 import (
 	"context"
 	"fmt"
+	"maps"
 
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
@@ -119,7 +120,7 @@ func convertIntegrationConfigToModel(ctx context.Context, integrationConfig map[
 	configMap := make(map[string]attr.Value)
 
 	for k, v := range integrationConfig {
-		vstr, err := common.JxToString(v)
+		vstr, err := common.JxToText(v)
 		if err != nil {
 			return types.Map{}, fmt.Errorf("failed to decode integration config value: %v", err)
 		}
@@ -133,3 +134,107 @@ func convertIntegrationConfigToModel(ctx context.Context, integrationConfig map[
 
 	return result, nil
 }
+
+// convertIntegrationConfigToDynamic converts an integration config to an object, keeping the JSON types of its values.
+func convertIntegrationConfigToDynamic(ctx context.Context, integrationConfig map[string]jx.Raw) (types.Dynamic, error) {
+	attributeTypes := make(map[string]attr.Type, len(integrationConfig))
+	attributes := make(map[string]attr.Value, len(integrationConfig))
+
+	for k, v := range integrationConfig {
+		value, err := common.JxToValue(ctx, v)
+		if err != nil {
+			return types.Dynamic{}, fmt.Errorf("failed to decode integration config value: %v", err)
+		}
+		attributeTypes[k] = value.Type(ctx)
+		attributes[k] = value
+	}
+
+	result, diags := types.ObjectValue(attributeTypes, attributes)
+	if diags.HasError() {
+		return types.Dynamic{}, fmt.Errorf("failed to parse integration config: %v", diags)
+	}
+
+	return types.DynamicValue(result), nil
+}
+
+// integrationConfigAttributes returns the values of an integration config by key. The config is an object, or a map
+// when all of its values have the same type.
+func integrationConfigAttributes(integrationConfig types.Dynamic) (map[string]attr.Value, error) {
+	if integrationConfig.IsNull() || integrationConfig.IsUnderlyingValueNull() {
+		return map[string]attr.Value{}, nil
+	}
+
+	switch value := integrationConfig.UnderlyingValue().(type) {
+	case types.Object:
+		return maps.Clone(value.Attributes()), nil
+	case types.Map:
+		return maps.Clone(value.Elements()), nil
+	}
+
+	return nil, fmt.Errorf("integration config must be an object of configuration values, e.g. { host = \"localhost\" }, got %s", integrationConfig.UnderlyingValue().Type(context.Background()))
+}
+
+// reconcileIntegrationConfig returns the integration config read from the API, with the values of the prior config
+// that are semantically equal JSON. When keysOnly is set, keys that aren't in the prior config are dropped. A prior
+// map, e.g. from a map(string) variable, is kept a map, since Terraform requires the state to match the config type.
+func reconcileIntegrationConfig(current types.Dynamic, prior types.Dynamic, keysOnly bool) types.Dynamic {
+	if prior.IsNull() || prior.IsUnknown() || prior.IsUnderlyingValueUnknown() {
+		if keysOnly {
+			return types.DynamicNull()
+		}
+		return current
+	}
+
+	currentAttributes, err := integrationConfigAttributes(current)
+	if err != nil {
+		return current
+	}
+	priorAttributes, err := integrationConfigAttributes(prior)
+	if err != nil {
+		return current
+	}
+
+	ctx := context.Background()
+	attributeTypes := make(map[string]attr.Type, len(currentAttributes))
+	attributes := make(map[string]attr.Value, len(currentAttributes))
+	for key, value := range currentAttributes {
+		priorValue, ok := priorAttributes[key]
+		if !ok && keysOnly {
+			continue
+		}
+
+		if ok && integrationConfigValuesEqual(priorValue, value) {
+			value = priorValue
+		}
+
+		attributeTypes[key] = value.Type(ctx)
+		attributes[key] = value
+	}
+
+	if priorMap, ok := prior.UnderlyingValue().(types.Map); ok {
+		if result, diags := types.MapValue(priorMap.ElementType(ctx), attributes); !diags.HasError() {
+			return types.DynamicValue(result)
+		}
+	}
+
+	result, diags := types.ObjectValue(attributeTypes, attributes)
+	if diags.HasError() {
+		return current
+	}
+
+	return types.DynamicValue(result)
+}
+
+func integrationConfigValuesEqual(a, b attr.Value) bool {
+	rawA, err := common.ValueToJx(a)
+	if err != nil {
+		return false
+	}
+
+	rawB, err := common.ValueToJx(b)
+	if err != nil {
+		return false
+	}
+
+	return common.JSONSemanticEqual(rawA, rawB)
+}
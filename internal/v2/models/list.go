@@ -0,0 +1,14 @@
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// NameListConfigModel is the configuration of list resources that can only be filtered by name.
+type NameListConfigModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type ResourceIntegrationsListConfigModel struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	ConnectorID types.String `tfsdk:"connector_id"`
+}
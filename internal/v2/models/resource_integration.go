@@ -3,14 +3,31 @@ package models
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
 	"github.com/go-faster/jx"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type ResourceIntegrationModel struct {
+	ID                     types.String         `tfsdk:"id"`
+	Name                   types.String         `tfsdk:"name"`
+	Type                   types.String         `tfsdk:"type"`
+	ConnectorID            types.String         `tfsdk:"connector_id"`
+	ConnectedResourceTypes types.List           `tfsdk:"connected_resource_types"`
+	IntegrationConfig      types.Dynamic        `tfsdk:"integration_config"`
+	SecretStoreConfig      *SecretStoreConfig   `tfsdk:"secret_store_config"`
+	CustomAccessDetails    types.String         `tfsdk:"custom_access_details"`
+	Owner                  *OwnerConfig         `tfsdk:"owner"`
+	OwnersMapping          *OwnersMappingConfig `tfsdk:"owners_mapping"`
+}
+
+// ResourceIntegrationDataModel is an integration of the apono_resource_integrations data source. Dynamic attributes
+// can't be nested in lists, so its integration_config is a map of strings, with JSON-encoded non-string values.
+type ResourceIntegrationDataModel struct {
 	ID                     types.String         `tfsdk:"id"`
 	Name                   types.String         `tfsdk:"name"`
 	Type                   types.String         `tfsdk:"type"`
@@ -23,6 +40,16 @@ type ResourceIntegrationModel struct {
 	OwnersMapping          *OwnersMappingConfig `tfsdk:"owners_mapping"`
 }
 
+// ResourceIntegrationResourceModel extends ResourceIntegrationModel with the attributes that only exist in the resource:
+// the write-only attributes, and the connector lookup and check settings, which aren't returned by the API.
+type ResourceIntegrationResourceModel struct {
+	ResourceIntegrationModel
+	ConnectorName              types.String `tfsdk:"connector_name"`
+	ConnectorCheck             types.String `tfsdk:"connector_check"`
+	IntegrationConfigWO        types.Map    `tfsdk:"integration_config_wo"`
+	IntegrationConfigWOVersion types.Int64  `tfsdk:"integration_config_wo_version"`
+}
+
 type OwnerConfig struct {
 	SourceIntegrationName types.String `tfsdk:"source_integration_name"`
 	AttributeType         types.String `tfsdk:"attribute_type"`
@@ -36,10 +63,82 @@ type OwnersMappingConfig struct {
 }
 
 type ResourceIntegrationsDataSourceModel struct {
-	Name         types.String               `tfsdk:"name"`
-	Type         types.String               `tfsdk:"type"`
-	ConnectorID  types.String               `tfsdk:"connector_id"`
-	Integrations []ResourceIntegrationModel `tfsdk:"integrations"`
+	Name         types.String                   `tfsdk:"name"`
+	Type         types.String                   `tfsdk:"type"`
+	ConnectorID  types.String                   `tfsdk:"connector_id"`
+	Integrations []ResourceIntegrationDataModel `tfsdk:"integrations"`
+}
+
+// NewResourceIntegrationResourceModel returns the resource model of an integration read from the API. The
+// connector_name and connector_check settings are kept from the prior model, and so are the integration_config
+// values that are semantically equal to the values read, so JSON formatting and string-encoded JSON don't cause
+// drift. When integration_config_wo is used, only the integration_config keys of the prior model are kept, so the
+// write-only values are not stored in the state.
+func NewResourceIntegrationResourceModel(model *ResourceIntegrationModel, prior *ResourceIntegrationResourceModel) *ResourceIntegrationResourceModel {
+	result := &ResourceIntegrationResourceModel{
+		ResourceIntegrationModel:   *model,
+		ConnectorName:              types.StringNull(),
+		ConnectorCheck:             types.StringNull(),
+		IntegrationConfigWO:        types.MapNull(types.StringType),
+		IntegrationConfigWOVersion: types.Int64Null(),
+	}
+
+	if prior == nil {
+		return result
+	}
+
+	result.ConnectorName = prior.ConnectorName
+	result.ConnectorCheck = prior.ConnectorCheck
+
+	writeOnly := !prior.IntegrationConfigWOVersion.IsNull()
+	if writeOnly {
+		result.IntegrationConfigWOVersion = prior.IntegrationConfigWOVersion
+	}
+
+	result.IntegrationConfig = reconcileIntegrationConfig(model.IntegrationConfig, prior.IntegrationConfig, writeOnly)
+
+	return result
+}
+
+// WithWriteOnlyIntegrationConfig returns the model with integration_config_wo merged into integration_config, to be sent to the API.
+func (m ResourceIntegrationResourceModel) WithWriteOnlyIntegrationConfig(ctx context.Context) (ResourceIntegrationModel, error) {
+	model := m.ResourceIntegrationModel
+	if m.IntegrationConfigWO.IsNull() {
+		return model, nil
+	}
+
+	integrationConfig, err := integrationConfigAttributes(m.IntegrationConfig)
+	if err != nil {
+		return model, fmt.Errorf("invalid integration_config: %w", err)
+	}
+
+	attributeTypes := make(map[string]attr.Type, len(integrationConfig))
+	for key, value := range integrationConfig {
+		attributeTypes[key] = value.Type(ctx)
+	}
+
+	var duplicateKeys []string
+	for key, value := range m.IntegrationConfigWO.Elements() {
+		if _, exists := integrationConfig[key]; exists {
+			duplicateKeys = append(duplicateKeys, key)
+			continue
+		}
+		integrationConfig[key] = value
+		attributeTypes[key] = types.StringType
+	}
+
+	if len(duplicateKeys) > 0 {
+		sort.Strings(duplicateKeys)
+		return model, fmt.Errorf("invalid integration_config_wo: keys %q are set in both the attribute and its write-only variant", duplicateKeys)
+	}
+
+	merged, diags := types.ObjectValue(attributeTypes, integrationConfig)
+	if diags.HasError() {
+		return model, fmt.Errorf("failed to merge integration_config_wo: %v", diags)
+	}
+	model.IntegrationConfig = types.DynamicValue(merged)
+
+	return model, nil
 }
 
 func ResourceIntegrationModelToCreateRequest(ctx context.Context, model ResourceIntegrationModel) (*client.CreateIntegrationV4, error) {
@@ -128,13 +227,18 @@ func ResourceIntegrationModelToUpdateRequest(ctx context.Context, model Resource
 }
 
 func getIntegrationConfig(model ResourceIntegrationModel) (map[string]jx.Raw, error) {
+	attributes, err := integrationConfigAttributes(model.IntegrationConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	integrationConfig := make(map[string]jx.Raw)
-	for k, v := range model.IntegrationConfig.Elements() {
-		strVal, ok := v.(types.String)
-		if !ok {
-			return nil, fmt.Errorf("failed to assert type for integration config value")
+	for k, v := range attributes {
+		raw, err := common.ValueToJx(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert integration config value %s: %w", k, err)
 		}
-		integrationConfig[k] = common.StringToJx(strVal.ValueString())
+		integrationConfig[k] = raw
 	}
 	return integrationConfig, nil
 }
@@ -205,8 +309,9 @@ func ResourceIntegrationToModel(ctx context.Context, integration *client.Integra
 		model.ConnectedResourceTypes = types.ListNull(types.StringType)
 	}
 
+	model.IntegrationConfig = types.DynamicNull()
 	if integration.IntegrationConfig != nil {
-		integrationConfig, err := convertIntegrationConfigToModel(ctx, integration.IntegrationConfig)
+		integrationConfig, err := convertIntegrationConfigToDynamic(ctx, integration.IntegrationConfig)
 		if err != nil {
 			return nil, err
 		}
@@ -256,9 +361,9 @@ func ResourceIntegrationToModel(ctx context.Context, integration *client.Integra
 }
 
 func ResourceIntegrationsToModel(ctx context.Context, integrations []client.IntegrationV4) (*ResourceIntegrationsDataSourceModel, error) {
-	var integrationModels []ResourceIntegrationModel
+	var integrationModels []ResourceIntegrationDataModel
 	for _, integration := range integrations {
-		model, err := ResourceIntegrationToModel(ctx, &integration)
+		model, err := ResourceIntegrationToDataModel(ctx, &integration)
 		if err != nil {
 			return nil, err
 		}
@@ -268,3 +373,33 @@ func ResourceIntegrationsToModel(ctx context.Context, integrations []client.Inte
 		Integrations: integrationModels,
 	}, nil
 }
+
+// ResourceIntegrationToDataModel converts an integration to the model of the apono_resource_integrations data source.
+func ResourceIntegrationToDataModel(ctx context.Context, integration *client.IntegrationV4) (*ResourceIntegrationDataModel, error) {
+	model, err := ResourceIntegrationToModel(ctx, integration)
+	if err != nil {
+		return nil, err
+	}
+
+	dataModel := &ResourceIntegrationDataModel{
+		ID:                     model.ID,
+		Name:                   model.Name,
+		Type:                   model.Type,
+		ConnectorID:            model.ConnectorID,
+		ConnectedResourceTypes: model.ConnectedResourceTypes,
+		IntegrationConfig:      types.MapNull(types.StringType),
+		SecretStoreConfig:      model.SecretStoreConfig,
+		CustomAccessDetails:    model.CustomAccessDetails,
+		Owner:                  model.Owner,
+		OwnersMapping:          model.OwnersMapping,
+	}
+
+	if integration.IntegrationConfig != nil {
+		dataModel.IntegrationConfig, err = convertIntegrationConfigToModel(ctx, integration.IntegrationConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return dataModel, nil
+}
@@ -1,9 +1,11 @@
 package models
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
 	"github.com/go-faster/jx"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -50,18 +52,18 @@ func TestResourceIntegrationModelToCreateRequest(t *testing.T) {
 			ConnectedResourceTypes: types.ListValueMust(types.StringType, []attr.Value{
 				types.StringValue("database"),
 			}),
-			IntegrationConfig: types.MapValueMust(types.StringType, configMap),
+			IntegrationConfig: types.DynamicValue(types.MapValueMust(types.StringType, configMap)),
 		}
 
 		req, err := ResourceIntegrationModelToCreateRequest(ctx, model)
 
 		require.NoError(t, err)
 		assert.NotNil(t, req.IntegrationConfig)
-		hostVal, isHostString := model.IntegrationConfig.Elements()["host"].(types.String)
+		hostVal, isHostString := model.IntegrationConfig.UnderlyingValue().(types.Map).Elements()["host"].(types.String)
 		require.True(t, isHostString)
 		assert.Equal(t, "localhost", hostVal.ValueString())
 
-		dbVal, isDbString := model.IntegrationConfig.Elements()["database"].(types.String)
+		dbVal, isDbString := model.IntegrationConfig.UnderlyingValue().(types.Map).Elements()["database"].(types.String)
 		require.True(t, isDbString)
 		assert.Equal(t, "postgres", dbVal.ValueString())
 	})
@@ -78,7 +80,7 @@ func TestResourceIntegrationModelToCreateRequest(t *testing.T) {
 			ConnectedResourceTypes: types.ListValueMust(types.StringType, []attr.Value{
 				types.StringValue("ssh-server"),
 			}),
-			IntegrationConfig: types.MapValueMust(types.StringType, configMap),
+			IntegrationConfig: types.DynamicValue(types.MapValueMust(types.StringType, configMap)),
 		}
 
 		req, err := ResourceIntegrationModelToCreateRequest(ctx, model)
@@ -94,6 +96,51 @@ func TestResourceIntegrationModelToCreateRequest(t *testing.T) {
 		assert.Equal(t, serversJSON, decoded)
 	})
 
+	t.Run("with native integration config values", func(t *testing.T) {
+		server := types.ObjectValueMust(
+			map[string]attr.Type{"host": types.StringType, "port": types.NumberType},
+			map[string]attr.Value{"host": types.StringValue("10.0.8.15"), "port": types.NumberValue(big.NewFloat(2222))},
+		)
+		model := ResourceIntegrationModel{
+			Name: types.StringValue("ssh-integration"),
+			Type: types.StringValue("ssh"),
+			ConnectedResourceTypes: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("ssh-server"),
+			}),
+			IntegrationConfig: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"servers": types.TupleType{ElemTypes: []attr.Type{server.Type(ctx)}},
+					"shell":   types.StringType,
+				},
+				map[string]attr.Value{
+					"servers": types.TupleValueMust([]attr.Type{server.Type(ctx)}, []attr.Value{server}),
+					"shell":   types.StringValue("/bin/bash"),
+				},
+			)),
+		}
+
+		req, err := ResourceIntegrationModelToCreateRequest(ctx, model)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `[{"host": "10.0.8.15", "port": 2222}]`, string(req.IntegrationConfig["servers"]))
+		assert.JSONEq(t, `"/bin/bash"`, string(req.IntegrationConfig["shell"]))
+	})
+
+	t.Run("with integration config that is not an object", func(t *testing.T) {
+		model := ResourceIntegrationModel{
+			Name: types.StringValue("test-integration"),
+			Type: types.StringValue("postgres"),
+			ConnectedResourceTypes: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("database"),
+			}),
+			IntegrationConfig: types.DynamicValue(types.StringValue("host=localhost")),
+		}
+
+		_, err := ResourceIntegrationModelToCreateRequest(ctx, model)
+
+		assert.ErrorContains(t, err, "must be an object")
+	})
+
 	t.Run("with AWS secret store config", func(t *testing.T) {
 		model := ResourceIntegrationModel{
 			Name: types.StringValue("test-integration"),
@@ -360,7 +407,7 @@ func TestResourceIntegrationToModel(t *testing.T) {
 		}
 
 		for key, expectedValue := range expectedConfig {
-			value, ok := model.IntegrationConfig.Elements()[key]
+			value, ok := model.IntegrationConfig.UnderlyingValue().(types.Object).Attributes()[key]
 			require.True(t, ok)
 
 			strValue, isString := value.(types.String)
@@ -370,6 +417,27 @@ func TestResourceIntegrationToModel(t *testing.T) {
 		}
 	})
 
+	t.Run("with JSON array integration config", func(t *testing.T) {
+		integration := &client.IntegrationV4{
+			ID:                     "integration-id",
+			Name:                   "ssh-integration",
+			Type:                   "ssh",
+			ConnectorID:            client.NewOptNilString("connector-id"),
+			ConnectedResourceTypes: client.NewOptNilStringArray([]string{"ssh-server"}),
+			IntegrationConfig: map[string]jx.Raw{
+				"servers": jx.Raw(`[{"host": "10.0.8.15"}]`),
+			},
+		}
+
+		model, err := ResourceIntegrationToModel(ctx, integration)
+
+		require.NoError(t, err)
+		servers, ok := model.IntegrationConfig.UnderlyingValue().(types.Object).Attributes()["servers"].(types.Tuple)
+		require.True(t, ok)
+		require.Len(t, servers.Elements(), 1)
+		assert.Equal(t, types.StringValue("10.0.8.15"), servers.Elements()[0].(types.Object).Attributes()["host"])
+	})
+
 	t.Run("with AWS secret store config", func(t *testing.T) {
 		integration := &client.IntegrationV4{
 			ID:                     "integration-id",
@@ -622,7 +690,7 @@ func TestResourceIntegrationModelToUpdateRequest(t *testing.T) {
 			ConnectedResourceTypes: types.ListValueMust(types.StringType, []attr.Value{
 				types.StringValue("database"),
 			}),
-			IntegrationConfig: types.MapValueMust(types.StringType, configMap),
+			IntegrationConfig: types.DynamicValue(types.MapValueMust(types.StringType, configMap)),
 		}
 
 		req, err := ResourceIntegrationModelToUpdateRequest(ctx, model)
@@ -630,15 +698,15 @@ func TestResourceIntegrationModelToUpdateRequest(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, req.IntegrationConfig)
 
-		hostVal, isHostString := model.IntegrationConfig.Elements()["host"].(types.String)
+		hostVal, isHostString := model.IntegrationConfig.UnderlyingValue().(types.Map).Elements()["host"].(types.String)
 		require.True(t, isHostString)
 		assert.Equal(t, "new-host", hostVal.ValueString())
 
-		portVal, isPortString := model.IntegrationConfig.Elements()["port"].(types.String)
+		portVal, isPortString := model.IntegrationConfig.UnderlyingValue().(types.Map).Elements()["port"].(types.String)
 		require.True(t, isPortString)
 		assert.Equal(t, "5433", portVal.ValueString())
 
-		dbVal, isDbString := model.IntegrationConfig.Elements()["database"].(types.String)
+		dbVal, isDbString := model.IntegrationConfig.UnderlyingValue().(types.Map).Elements()["database"].(types.String)
 		require.True(t, isDbString)
 		assert.Equal(t, "test-db", dbVal.ValueString())
 	})
@@ -671,7 +739,7 @@ func TestResourceIntegrationModelToUpdateRequest(t *testing.T) {
 			ConnectedResourceTypes: types.ListValueMust(types.StringType, []attr.Value{
 				types.StringValue("ssh-server"),
 			}),
-			IntegrationConfig: types.MapValueMust(types.StringType, configMap),
+			IntegrationConfig: types.DynamicValue(types.MapValueMust(types.StringType, configMap)),
 		}
 
 		req, err := ResourceIntegrationModelToUpdateRequest(ctx, model)
@@ -870,3 +938,100 @@ func TestResourceIntegrationModelToUpdateRequest(t *testing.T) {
 		assert.Equal(t, "new-source-integration", mapping.SourceIntegrationReference.Value)
 	})
 }
+
+func TestNewResourceIntegrationResourceModel(t *testing.T) {
+	ctx := t.Context()
+
+	integration := &client.IntegrationV4{
+		ID:                     "integration-id",
+		Name:                   "ssh-integration",
+		Type:                   "ssh",
+		ConnectorID:            client.NewOptNilString("connector-id"),
+		ConnectedResourceTypes: client.NewOptNilStringArray([]string{"ssh-server"}),
+		IntegrationConfig: map[string]jx.Raw{
+			"servers":  jx.Raw(`[{"host":"10.0.8.15","port":"2222"}]`),
+			"shell":    common.StringToJx("/bin/sh"),
+			"port":     common.StringToJx("22"),
+			"password": common.StringToJx("secret"),
+		},
+	}
+	model, err := ResourceIntegrationToModel(ctx, integration)
+	require.NoError(t, err)
+
+	prior := NewResourceIntegrationResourceModel(model, nil)
+	prior.IntegrationConfig = types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"servers": types.StringType, "shell": types.StringType, "port": types.NumberType},
+		map[string]attr.Value{
+			"servers": types.StringValue(`[{ "host": "10.0.8.15", "port": "2222" }]`),
+			"shell":   types.StringValue("/bin/bash"),
+			"port":    types.NumberValue(big.NewFloat(22)),
+		},
+	))
+
+	t.Run("keeps semantically equal prior values", func(t *testing.T) {
+		result := NewResourceIntegrationResourceModel(model, prior)
+
+		attributes := result.IntegrationConfig.UnderlyingValue().(types.Object).Attributes()
+		assert.Equal(t, types.StringValue(`[{ "host": "10.0.8.15", "port": "2222" }]`), attributes["servers"])
+		assert.Equal(t, types.StringValue("/bin/sh"), attributes["shell"])
+		assert.Equal(t, types.NumberValue(big.NewFloat(22)), attributes["port"])
+		assert.Equal(t, types.StringValue("secret"), attributes["password"])
+	})
+
+	t.Run("keeps only prior keys with write-only config", func(t *testing.T) {
+		writeOnlyPrior := *prior
+		writeOnlyPrior.IntegrationConfigWOVersion = types.Int64Value(1)
+
+		result := NewResourceIntegrationResourceModel(model, &writeOnlyPrior)
+
+		attributes := result.IntegrationConfig.UnderlyingValue().(types.Object).Attributes()
+		assert.NotContains(t, attributes, "password")
+		assert.Len(t, attributes, 3)
+		assert.Equal(t, int64(1), result.IntegrationConfigWOVersion.ValueInt64())
+	})
+
+	t.Run("keeps a map-typed prior a map", func(t *testing.T) {
+		mapPrior := *prior
+		mapPrior.IntegrationConfig = types.DynamicValue(types.MapValueMust(types.StringType, map[string]attr.Value{
+			"servers":  types.StringValue(`[{ "host": "10.0.8.15", "port": "2222" }]`),
+			"shell":    types.StringValue("/bin/bash"),
+			"port":     types.StringValue("22"),
+			"password": types.StringValue("secret"),
+		}))
+
+		result := NewResourceIntegrationResourceModel(model, &mapPrior)
+
+		expected := types.DynamicValue(types.MapValueMust(types.StringType, map[string]attr.Value{
+			"servers":  types.StringValue(`[{ "host": "10.0.8.15", "port": "2222" }]`),
+			"shell":    types.StringValue("/bin/sh"),
+			"port":     types.StringValue("22"),
+			"password": types.StringValue("secret"),
+		}))
+		assert.True(t, expected.Equal(result.IntegrationConfig), "got %s", result.IntegrationConfig)
+	})
+}
+
+func TestResourceIntegrationToDataModel(t *testing.T) {
+	ctx := t.Context()
+
+	integration := &client.IntegrationV4{
+		ID:                     "integration-id",
+		Name:                   "ssh-integration",
+		Type:                   "ssh",
+		ConnectorID:            client.NewOptNilString("connector-id"),
+		ConnectedResourceTypes: client.NewOptNilStringArray([]string{"ssh-server"}),
+		IntegrationConfig: map[string]jx.Raw{
+			"servers": jx.Raw(`[ {"host": "10.0.8.15"} ]`),
+			"shell":   common.StringToJx("/bin/bash"),
+		},
+	}
+
+	model, err := ResourceIntegrationToDataModel(ctx, integration)
+
+	require.NoError(t, err)
+	assert.Equal(t, "ssh-integration", model.Name.ValueString())
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"servers": types.StringValue(`[{"host":"10.0.8.15"}]`),
+		"shell":   types.StringValue("/bin/bash"),
+	}), model.IntegrationConfig)
+}
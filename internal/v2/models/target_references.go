@@ -0,0 +1,110 @@
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// targetReference returns the reference the API expects for the integration, bundle or access scope of an access
+// target, which is either its ID or its name.
+func targetReference(name, id types.String) string {
+	if isKnownString(id) {
+		return id.ValueString()
+	}
+
+	return name.ValueString()
+}
+
+// referenceFields holds pointers to the name and ID of the object referenced by an access target, or nils.
+type referenceFields struct {
+	kind string
+	name *types.String
+	id   *types.String
+}
+
+// KeepConfiguredAccessFlowTargetReferences keeps either the name or the ID of each object referenced by the access
+// targets of an access flow converted from the API, whichever the matching access target of the prior model, i.e.
+// the plan or the state, references. Access targets without a prior counterpart, e.g. on import, keep the name.
+func KeepConfiguredAccessFlowTargetReferences(prior, current *AccessFlowV2Model) {
+	if current == nil {
+		return
+	}
+
+	var priorTargets []AccessFlowAccessTargetModel
+	if prior != nil {
+		priorTargets = prior.AccessTargets
+	}
+
+	keepConfiguredReferences(priorTargets, current.AccessTargets, func(target *AccessFlowAccessTargetModel) referenceFields {
+		switch {
+		case target.Integration != nil:
+			return referenceFields{"integration", &target.Integration.IntegrationName, &target.Integration.IntegrationID}
+		case target.Bundle != nil:
+			return referenceFields{"bundle", &target.Bundle.Name, &target.Bundle.ID}
+		case target.AccessScope != nil:
+			return referenceFields{"access_scope", &target.AccessScope.Name, &target.AccessScope.ID}
+		default:
+			return referenceFields{}
+		}
+	})
+}
+
+// KeepConfiguredBundleTargetReferences is KeepConfiguredAccessFlowTargetReferences for bundles.
+func KeepConfiguredBundleTargetReferences(prior, current *BundleV2Model) {
+	if current == nil {
+		return
+	}
+
+	var priorTargets []BundleAccessTargetModel
+	if prior != nil {
+		priorTargets = prior.AccessTargets
+	}
+
+	keepConfiguredReferences(priorTargets, current.AccessTargets, func(target *BundleAccessTargetModel) referenceFields {
+		switch {
+		case target.Integration != nil:
+			return referenceFields{"integration", &target.Integration.IntegrationName, &target.Integration.IntegrationID}
+		case target.AccessScope != nil:
+			return referenceFields{"access_scope", &target.AccessScope.Name, &target.AccessScope.ID}
+		default:
+			return referenceFields{}
+		}
+	})
+}
+
+func keepConfiguredReferences[T any](prior, current []T, fields func(*T) referenceFields) {
+	matched := make([]bool, len(prior))
+
+	for i := range current {
+		reference := fields(&current[i])
+		if reference.name == nil {
+			continue
+		}
+
+		byID := false
+		for j := range prior {
+			priorReference := fields(&prior[j])
+			if matched[j] || priorReference.kind != reference.kind {
+				continue
+			}
+
+			if isKnownString(*priorReference.id) && priorReference.id.Equal(*reference.id) {
+				matched[j] = true
+				byID = true
+				break
+			}
+
+			if isKnownString(*priorReference.name) && priorReference.name.Equal(*reference.name) {
+				matched[j] = true
+				break
+			}
+		}
+
+		if byID {
+			*reference.name = types.StringNull()
+		} else {
+			*reference.id = types.StringNull()
+		}
+	}
+}
+
+func isKnownString(value types.String) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
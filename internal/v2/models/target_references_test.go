@@ -0,0 +1,103 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/testcommon"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeepConfiguredAccessFlowTargetReferences(t *testing.T) {
+	newCurrent := func() *AccessFlowV2Model {
+		return &AccessFlowV2Model{
+			AccessTargets: []AccessFlowAccessTargetModel{
+				{Integration: &IntegrationTargetModel{IntegrationName: types.StringValue("postgres renamed"), IntegrationID: types.StringValue("integration-1")}},
+				{Bundle: &AccessFlowTargetBundleModel{Name: types.StringValue("bundle"), ID: types.StringValue("bundle-1")}},
+				{AccessScope: &AccessScopeTargetModel{Name: types.StringValue("scope"), ID: types.StringValue("scope-1")}},
+			},
+		}
+	}
+
+	t.Run("KeepsConfiguredReferences", func(t *testing.T) {
+		prior := &AccessFlowV2Model{
+			AccessTargets: []AccessFlowAccessTargetModel{
+				{Integration: &IntegrationTargetModel{IntegrationName: types.StringNull(), IntegrationID: types.StringValue("integration-1")}},
+				{Bundle: &AccessFlowTargetBundleModel{Name: types.StringValue("bundle"), ID: types.StringNull()}},
+				{AccessScope: &AccessScopeTargetModel{Name: types.StringNull(), ID: types.StringValue("scope-1")}},
+			},
+		}
+
+		current := newCurrent()
+		KeepConfiguredAccessFlowTargetReferences(prior, current)
+
+		assert.Equal(t, prior.AccessTargets[0].Integration.IntegrationName, current.AccessTargets[0].Integration.IntegrationName)
+		assert.Equal(t, prior.AccessTargets[0].Integration.IntegrationID, current.AccessTargets[0].Integration.IntegrationID)
+		assert.Equal(t, *prior.AccessTargets[1].Bundle, *current.AccessTargets[1].Bundle)
+		assert.Equal(t, *prior.AccessTargets[2].AccessScope, *current.AccessTargets[2].AccessScope)
+	})
+
+	t.Run("RenamedTargetReferencedByName", func(t *testing.T) {
+		prior := &AccessFlowV2Model{
+			AccessTargets: []AccessFlowAccessTargetModel{
+				{Integration: &IntegrationTargetModel{IntegrationName: types.StringValue("postgres"), IntegrationID: types.StringNull()}},
+			},
+		}
+
+		current := newCurrent()
+		KeepConfiguredAccessFlowTargetReferences(prior, current)
+
+		assert.Equal(t, types.StringValue("postgres renamed"), current.AccessTargets[0].Integration.IntegrationName)
+		assert.True(t, current.AccessTargets[0].Integration.IntegrationID.IsNull())
+	})
+
+	t.Run("NoPrior", func(t *testing.T) {
+		current := newCurrent()
+		KeepConfiguredAccessFlowTargetReferences(nil, current)
+
+		assert.Equal(t, types.StringValue("bundle"), current.AccessTargets[1].Bundle.Name)
+		assert.True(t, current.AccessTargets[1].Bundle.ID.IsNull())
+		assert.True(t, current.AccessTargets[2].AccessScope.ID.IsNull())
+	})
+}
+
+func TestKeepConfiguredBundleTargetReferences(t *testing.T) {
+	prior := &BundleV2Model{
+		AccessTargets: []BundleAccessTargetModel{
+			{AccessScope: &AccessScopeTargetModel{Name: types.StringNull(), ID: types.StringValue("scope-1")}},
+		},
+	}
+	current := &BundleV2Model{
+		AccessTargets: []BundleAccessTargetModel{
+			{AccessScope: &AccessScopeTargetModel{Name: types.StringValue("scope"), ID: types.StringValue("scope-1")}},
+		},
+	}
+
+	KeepConfiguredBundleTargetReferences(prior, current)
+
+	assert.Equal(t, *prior.AccessTargets[0].AccessScope, *current.AccessTargets[0].AccessScope)
+}
+
+func TestTargetReferencesToUpsertRequest(t *testing.T) {
+	ctx := t.Context()
+
+	targets := []AccessFlowAccessTargetModel{
+		{Integration: &IntegrationTargetModel{
+			IntegrationName: types.StringNull(),
+			IntegrationID:   types.StringValue("integration-1"),
+			ResourceType:    types.StringValue("postgresql-database"),
+			Permissions:     testcommon.CreateTestStringSet(t, []string{"READ_ONLY"}),
+		}},
+		{Bundle: &AccessFlowTargetBundleModel{Name: types.StringValue("bundle"), ID: types.StringNull()}},
+		{AccessScope: &AccessScopeTargetModel{Name: types.StringNull(), ID: types.StringValue("scope-1")}},
+	}
+
+	result, err := convertAccessTargetsToUpsertRequest(ctx, targets)
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+
+	assert.Equal(t, "integration-1", result[0].Integration.Value.IntegrationReference)
+	assert.Equal(t, "bundle", result[1].Bundle.Value.BundleReference)
+	assert.Equal(t, "scope-1", result[2].AccessScope.Value.AccessScopeReference)
+}
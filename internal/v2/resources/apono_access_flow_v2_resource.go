@@ -3,13 +3,19 @@ package resources
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"slices"
 
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/schemas"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/services"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -22,27 +28,52 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.ResourceWithConfigure   = &AponoAccessFlowV2Resource{}
-	_ resource.ResourceWithImportState = &AponoAccessFlowV2Resource{}
+	_ resource.ResourceWithConfigure      = &AponoAccessFlowV2Resource{}
+	_ resource.ResourceWithImportState    = &AponoAccessFlowV2Resource{}
+	_ resource.ResourceWithIdentity       = &AponoAccessFlowV2Resource{}
+	_ resource.ResourceWithModifyPlan     = &AponoAccessFlowV2Resource{}
+	_ resource.ResourceWithMoveState      = &AponoAccessFlowV2Resource{}
+	_ resource.ResourceWithUpgradeState   = &AponoAccessFlowV2Resource{}
+	_ resource.ResourceWithValidateConfig = &AponoAccessFlowV2Resource{}
+
+	logicalOperators = []string{"AND", "OR"}
+	requestScopes    = []string{requestScopeSelf, requestScopeOthers, "direct_reports"}
 
 	defaultRequestScopes = setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{
-		types.StringValue("self"),
+		types.StringValue(requestScopeSelf),
 	}))
 )
 
+const (
+	accessFlowTriggerSelfServe = "SELF_SERVE"
+	accessFlowTriggerAutomatic = "AUTOMATIC"
+
+	requestScopeSelf   = "self"
+	requestScopeOthers = "others"
+
+	approverCheckWarn  = "warn"
+	approverCheckError = "error"
+)
+
+// accessFlowV2SchemaVersion is the schema version of apono_access_flow_v2.
+const accessFlowV2SchemaVersion = 1
+
 func NewAponoAccessFlowV2Resource() resource.Resource {
 	return &AponoAccessFlowV2Resource{}
 }
 
 type AponoAccessFlowV2Resource struct {
-	client client.Invoker
+	client   client.Invoker
+	settings common.ProviderSettings
 }
 
 func (r *AponoAccessFlowV2Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_access_flow_v2"
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 type IdentityConditionSchemaType string
@@ -91,6 +122,9 @@ For the user attribute specifically, you may also use the user's email.`
 				Optional:    true,
 				Default:     stringdefault.StaticString(common.DefaultMatchOperator),
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(common.MatchOperators...),
+				},
 			},
 			"values": schema.ListAttribute{
 				Description: valuesDescription,
@@ -103,6 +137,7 @@ For the user attribute specifically, you may also use the user's email.`
 
 func (r *AponoAccessFlowV2Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     accessFlowV2SchemaVersion,
 		Description: "Manages an Apono Access Flow that defines how users or groups can request or automatically be granted access to integrations, bundles, or access scopes under specific conditions and policies.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -129,6 +164,9 @@ func (r *AponoAccessFlowV2Resource) Schema(_ context.Context, _ resource.SchemaR
 			"trigger": schema.StringAttribute{
 				Description: `The trigger type for the access flow. Possible values: SELF_SERVE, AUTOMATIC.`,
 				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(accessFlowTriggerSelfServe, accessFlowTriggerAutomatic),
+				},
 			},
 			"grant_duration_in_min": schema.Int32Attribute{
 				Description: "How long access is granted, in minutes. If not specified, the grant duration defaults to indefinite.",
@@ -139,21 +177,33 @@ func (r *AponoAccessFlowV2Resource) Schema(_ context.Context, _ resource.SchemaR
 				Optional:    true,
 				Attributes: map[string]schema.Attribute{
 					"start_time": schema.StringAttribute{
-						Description: "Start time (e.g., 08:00).",
+						Description: "Start time in 24-hour HH:MM format (e.g., 08:00).",
 						Required:    true,
+						Validators: []validator.String{
+							common.TimeOfDayValidator(),
+						},
 					},
 					"end_time": schema.StringAttribute{
-						Description: "End time (e.g., 17:00).",
+						Description: "End time in 24-hour HH:MM format (e.g., 17:00). Must be after start_time.",
 						Required:    true,
+						Validators: []validator.String{
+							common.TimeOfDayValidator(),
+						},
 					},
 					"days_of_week": schema.SetAttribute{
 						Description: "Days when access is allowed. (e.g., ['MONDAY', 'TUESDAY']).",
 						ElementType: types.StringType,
 						Required:    true,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.OneOf(common.DaysOfWeek...)),
+						},
 					},
 					"time_zone": schema.StringAttribute{
-						Description: "Timezone name (e.g., Asia/Jerusalem).",
+						Description: "IANA timezone name (e.g., Asia/Jerusalem).",
 						Required:    true,
+						Validators: []validator.String{
+							common.TimeZoneValidator(),
+						},
 					},
 				},
 			},
@@ -164,6 +214,9 @@ func (r *AponoAccessFlowV2Resource) Schema(_ context.Context, _ resource.SchemaR
 					"approval_mode": schema.StringAttribute{
 						Description: "Possible values: ANY_OF or ALL_OF. Specifies the logical condition for approvals: ANY_OF: The request is granted if at least one approver from the list approves. ALL_OF: The request is granted only if all approvers in the list approve.",
 						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("ANY_OF", "ALL_OF"),
+						},
 					},
 					"approver_groups": schema.SetNestedAttribute{
 						Description: "List of approver groups. Cannot be empty.",
@@ -173,6 +226,9 @@ func (r *AponoAccessFlowV2Resource) Schema(_ context.Context, _ resource.SchemaR
 								"logical_operator": schema.StringAttribute{
 									Description: `Possible values: AND or OR`,
 									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(logicalOperators...),
+									},
 								},
 								"approvers": schema.ListNestedAttribute{
 									Description:  "List of approvers.",
@@ -205,6 +261,9 @@ func (r *AponoAccessFlowV2Resource) Schema(_ context.Context, _ resource.SchemaR
 								"logical_operator": schema.StringAttribute{
 									Description: `Possible values: AND or OR`,
 									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(logicalOperators...),
+									},
 								},
 								"approvers": schema.ListNestedAttribute{
 									Description:  "List of approvers.",
@@ -216,6 +275,36 @@ func (r *AponoAccessFlowV2Resource) Schema(_ context.Context, _ resource.SchemaR
 					},
 				},
 			},
+			"approver_check": schema.StringAttribute{
+				MarkdownDescription: "Analyzes `approver_policy` and `escalation_policy` at plan time, when the access flow is created or its approvers, requestors or `requester_cannot_approve_self` change, by resolving user, group and user attribute conditions to the Apono users they match. " +
+					"It reports `ALL_OF` approver groups or approver policies that match no users, escalation tiers that only notify approvers who were already notified, and a single approver who is also a requestor when `requester_cannot_approve_self` is set. " +
+					"Set to `warn` to report problems as warnings, or `error` to fail the plan. By default the approvers aren't analyzed and `effective_approvers` isn't computed.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(approverCheckWarn, approverCheckError),
+				},
+			},
+			"effective_approvers": schema.ListNestedAttribute{
+				MarkdownDescription: "The approvers notified at each tier of a request, as resolved when `approver_check` last analyzed the access flow. Only computed when `approver_check` is set.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tier": schema.Int32Attribute{
+							MarkdownDescription: "`0` for the `approver_policy`, and the position of the escalation tier in `escalation_policy.approver_groups`, starting at `1`.",
+							Computed:            true,
+						},
+						"users": schema.SetAttribute{
+							Description: "Primary emails of the active users who can approve at this tier, regardless of the request.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"dynamic": schema.BoolAttribute{
+							Description: "Whether the tier also has approvers that are resolved per request, such as managers, resource owners or on-call shifts, which aren't included in users.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 			"requestors": schema.SingleNestedAttribute{
 				Description: "List of users who can request access, based on identity attributes (e.g., users, groups, or shifts) and the conditions under which they can request access.\nIn self-serve access flows, requestors specify who is allowed to submit an access request.\nIn automatic access flows, requestors specify who will automatically receive access when conditions are met (equivalent to \"grantees\" in the UI).",
 				Required:    true,
@@ -223,6 +312,9 @@ func (r *AponoAccessFlowV2Resource) Schema(_ context.Context, _ resource.SchemaR
 					"logical_operator": schema.StringAttribute{
 						Description: `Specifies the logical operator to be used between the requestors in the list. Possible values: "AND" or "OR".`,
 						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(logicalOperators...),
+						},
 					},
 					"conditions": schema.ListNestedAttribute{
 						Description:  "List of conditions. Cannot be empty.",
@@ -246,6 +338,9 @@ Defaults to ["self"].`,
 						Computed:    true,
 						Default:     defaultRequestScopes,
 						ElementType: types.StringType,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.OneOf(requestScopes...)),
+						},
 					},
 					"grantees": schema.SingleNestedAttribute{
 						Description: "Applicable only when \"others\" is included in request_scope. Defines the set of users or attributes who can be selected as recipients of the access.",
@@ -254,6 +349,9 @@ Defaults to ["self"].`,
 							"logical_operator": schema.StringAttribute{
 								Description: `Specifies the logical operator to be used between the grantees in the list. Possible values: "AND" or "OR".`,
 								Required:    true,
+								Validators: []validator.String{
+									stringvalidator.OneOf(logicalOperators...),
+								},
 							},
 							"conditions": schema.ListNestedAttribute{
 								Description:  "List of conditions. Cannot be empty.",
@@ -269,17 +367,8 @@ Defaults to ["self"].`,
 				Required:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"integration": schemas.GetIntegrationTargetSchema(schemas.ResourceMode),
-						"bundle": schema.SingleNestedAttribute{
-							Description: "Bundle target.",
-							Optional:    true,
-							Attributes: map[string]schema.Attribute{
-								"name": schema.StringAttribute{
-									Description: "Name of the bundle.",
-									Required:    true,
-								},
-							},
-						},
+						"integration":  schemas.GetIntegrationTargetSchema(schemas.ResourceMode),
+						"bundle":       schemas.GetBundleTargetSchema(schemas.ResourceMode),
 						"access_scope": schemas.GetAccessScopeTargetSchema(schemas.ResourceMode),
 					},
 				},
@@ -317,10 +406,15 @@ Defaults to ["self"].`,
 						Computed:    true,
 					},
 					"labels": schema.SetAttribute{
-						Description: "Custom labels for organizational use.",
+						Description: "Custom labels for organizational use. Labels configured in the provider default_labels are added automatically and must not be repeated here.",
 						Optional:    true,
 						ElementType: types.StringType,
 					},
+					"labels_all": schema.SetAttribute{
+						Description: "All labels of the access flow, including those inherited from the provider default_labels.",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
 					"max_extensions": schema.Int32Attribute{
 						Description: "Maximum number of times a user can extend the access duration. Set to 0 to disable extensions. Defaults to 0.",
 						Optional:    true,
@@ -345,11 +439,334 @@ Defaults to ["self"].`,
 	}
 }
 
+func (r *AponoAccessFlowV2Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.GetResourceIdentitySchema("access flow")
+}
+
 func (r *AponoAccessFlowV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	common.ConfigureResourceClientInvoker(ctx, req, resp, &r.client)
+	common.ConfigureResourceSettings(ctx, req, resp, &r.settings)
+}
+
+// ValidateConfig rejects attributes that don't apply to the trigger of the access flow and inconsistent settings,
+// so that they fail at validation instead of at apply.
+func (r *AponoAccessFlowV2Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var trigger types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trigger"), &trigger)...)
+
+	objects := map[string]types.Object{}
+	for _, name := range []string{"timeframe", "approver_policy", "escalation_policy", "request_for", "settings"} {
+		var value types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+		objects[name] = value
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	automatic := trigger.ValueString() == accessFlowTriggerAutomatic
+
+	if automatic {
+		for _, name := range []string{"timeframe", "approver_policy", "escalation_policy", "request_for"} {
+			// Unknown values, e.g. conditional expressions, may still resolve to null.
+			if !objects[name].IsNull() && !objects[name].IsUnknown() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Invalid access flow configuration",
+					fmt.Sprintf("%s is only applicable in self-serve access flows and must not be set when trigger is %q.", name, accessFlowTriggerAutomatic),
+				)
+			}
+		}
+	}
+
+	if timeframe := objects["timeframe"]; !timeframe.IsNull() && !timeframe.IsUnknown() {
+		validateAccessFlowTimeframeConfig(timeframe, &resp.Diagnostics)
+	}
+
+	if settings := objects["settings"]; !settings.IsNull() && !settings.IsUnknown() {
+		validateAccessFlowSettingsConfig(settings, automatic, &resp.Diagnostics)
+	}
+
+	if requestFor := objects["request_for"]; !requestFor.IsNull() && !requestFor.IsUnknown() {
+		validateAccessFlowRequestForConfig(requestFor, &resp.Diagnostics)
+	}
+}
+
+func validateAccessFlowTimeframeConfig(timeframe types.Object, diags *diag.Diagnostics) {
+	attributes := timeframe.Attributes()
+
+	startTime, _ := attributes["start_time"].(types.String)
+	endTime, _ := attributes["end_time"].(types.String)
+	if startTime.IsNull() || startTime.IsUnknown() || endTime.IsNull() || endTime.IsUnknown() {
+		return
+	}
+
+	// Malformed times are reported by the attribute validators.
+	start, err := common.ParseTimeOfDay(startTime.ValueString())
+	if err != nil {
+		return
+	}
+	end, err := common.ParseTimeOfDay(endTime.ValueString())
+	if err != nil {
+		return
+	}
+
+	if !start.Before(end) {
+		diags.AddAttributeError(
+			path.Root("timeframe").AtName("end_time"),
+			"Invalid access flow configuration",
+			fmt.Sprintf("end_time %q must be after start_time %q. Timeframes spanning midnight are not supported.", endTime.ValueString(), startTime.ValueString()),
+		)
+	}
+}
+
+func validateAccessFlowSettingsConfig(settings types.Object, automatic bool, diags *diag.Diagnostics) {
+	settingsPath := path.Root("settings")
+	attributes := settings.Attributes()
+
+	if automatic {
+		for _, name := range []string{"justification_required", "require_approver_reason", "requester_cannot_approve_self", "require_mfa"} {
+			if value, ok := attributes[name].(types.Bool); ok && value.ValueBool() {
+				diags.AddAttributeError(
+					settingsPath.AtName(name),
+					"Invalid access flow configuration",
+					fmt.Sprintf("%s is only applicable in self-serve access flows and must be false or unset when trigger is %q.", name, accessFlowTriggerAutomatic),
+				)
+			}
+		}
+	}
+
+	maxExtensions, _ := attributes["max_extensions"].(types.Int32)
+	extensionDurationInMin, _ := attributes["extension_duration_in_min"].(types.Int32)
+	if extensionDurationInMin.ValueInt32() > 0 && !maxExtensions.IsUnknown() && maxExtensions.ValueInt32() == 0 {
+		diags.AddAttributeError(
+			settingsPath.AtName("extension_duration_in_min"),
+			"Invalid access flow configuration",
+			"extension_duration_in_min only applies when max_extensions is 1 or more. Set max_extensions or remove extension_duration_in_min.",
+		)
+	}
+}
+
+func validateAccessFlowRequestForConfig(requestFor types.Object, diags *diag.Diagnostics) {
+	attributes := requestFor.Attributes()
+
+	grantees, _ := attributes["grantees"].(types.Object)
+	scopes, _ := attributes["request_scopes"].(types.Set)
+	if grantees.IsNull() || scopes.IsUnknown() {
+		return
+	}
+
+	for _, scope := range scopes.Elements() {
+		if scope.IsUnknown() || scope.Equal(types.StringValue(requestScopeOthers)) {
+			return
+		}
+	}
+
+	diags.AddAttributeError(
+		path.Root("request_for").AtName("grantees"),
+		"Invalid access flow configuration",
+		fmt.Sprintf("grantees only applies when request_scopes includes %q.", requestScopeOthers),
+	)
+}
+
+func (r *AponoAccessFlowV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkReferences(ctx, req)...)
+	r.checkApprovers(ctx, req, resp)
+
+	var settings types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("settings"), &settings)...)
+	if resp.Diagnostics.HasError() || settings.IsNull() || settings.IsUnknown() {
+		return
+	}
+
+	labelsPath := path.Root("settings").AtName("labels")
+	labelsAllPath := path.Root("settings").AtName("labels_all")
+
+	var labels types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, labelsPath, &labels)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if labels.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, labelsAllPath, types.SetUnknown(types.StringType))...)
+		return
+	}
+
+	var resourceLabels []string
+	if !labels.IsNull() {
+		resp.Diagnostics.Append(labels.ElementsAs(ctx, &resourceLabels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for _, label := range resourceLabels {
+		if slices.Contains(r.settings.DefaultLabels, label) {
+			resp.Diagnostics.AddAttributeError(
+				labelsPath,
+				"Duplicate access flow label",
+				fmt.Sprintf("Label %q is already configured in the provider default_labels. Remove it from the access flow labels.", label),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	labelsAll, diags := types.SetValueFrom(ctx, types.StringType, models.MergeLabels(resourceLabels, r.settings.DefaultLabels))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, labelsAllPath, labelsAll)...)
+}
+
+// checkReferences warns about access targets and condition source integrations that don't exist in the tenant.
+// It only lists the tenant when they are created or changed.
+func (r *AponoAccessFlowV2Resource) checkReferences(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.client == nil {
+		return diags
+	}
+
+	// Plans with unknown nested objects can't be read into the model, and are checked once they are known.
+	var plan models.AccessFlowV2Model
+	if req.Plan.Get(ctx, &plan).HasError() {
+		return diags
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state models.AccessFlowV2Model
+		if !req.State.Get(ctx, &state).HasError() &&
+			reflect.DeepEqual(plan.AccessTargets, state.AccessTargets) &&
+			reflect.DeepEqual(plan.Requestors, state.Requestors) &&
+			reflect.DeepEqual(plan.RequestFor, state.RequestFor) {
+			return diags
+		}
+	}
+
+	checker := services.NewReferenceChecker(r.client)
+
+	if plan.Requestors != nil {
+		diags.Append(checker.CheckConditions(ctx, path.Root("requestors").AtName("conditions"), plan.Requestors.Conditions)...)
+	}
+	if plan.RequestFor != nil && plan.RequestFor.Grantees != nil {
+		diags.Append(checker.CheckConditions(ctx, path.Root("request_for").AtName("grantees").AtName("conditions"), plan.RequestFor.Grantees.Conditions)...)
+	}
+
+	for i, target := range plan.AccessTargets {
+		targetPath := path.Root("access_targets").AtListIndex(i)
+		diags.Append(checker.CheckIntegrationTarget(ctx, targetPath.AtName("integration"), target.Integration)...)
+		diags.Append(checker.CheckBundleTarget(ctx, targetPath.AtName("bundle"), target.Bundle)...)
+		diags.Append(checker.CheckAccessScopeTarget(ctx, targetPath.AtName("access_scope"), target.AccessScope)...)
+	}
+
+	return diags
+}
+
+// checkApprovers analyzes the approvers when approver_check is set, and plans effective_approvers. The tenant is only
+// listed when the access flow is created or its approvers, requestors, requester_cannot_approve_self or approver_check
+// change, otherwise the prior analysis is kept.
+func (r *AponoAccessFlowV2Resource) checkApprovers(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	effectiveApproversPath := path.Root("effective_approvers")
+	noEffectiveApprovers := types.ListNull(models.EffectiveApproversTierType)
+
+	var approverCheck types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("approver_check"), &approverCheck)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if approverCheck.IsNull() || r.client == nil {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, effectiveApproversPath, noEffectiveApprovers)...)
+		return
+	}
+
+	// Plans with unknown nested objects can't be read into the model, and are analyzed once they are known.
+	var plan models.AccessFlowV2Model
+	if approverCheck.IsUnknown() || req.Plan.Get(ctx, &plan).HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state models.AccessFlowV2Model
+		if !req.State.Get(ctx, &state).HasError() &&
+			!state.EffectiveApprovers.IsNull() &&
+			state.ApproverCheck.Equal(plan.ApproverCheck) &&
+			reflect.DeepEqual(plan.ApproverPolicy, state.ApproverPolicy) &&
+			reflect.DeepEqual(plan.EscalationPolicy, state.EscalationPolicy) &&
+			reflect.DeepEqual(plan.Requestors, state.Requestors) &&
+			requesterCannotApproveSelf(&plan).Equal(requesterCannotApproveSelf(&state)) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, effectiveApproversPath, state.EffectiveApprovers)...)
+			return
+		}
+	}
+
+	resolver, err := services.NewApproverResolver(ctx, r.client)
+	if err != nil {
+		tflog.Warn(ctx, "Could not list users and groups, skipping the approver check", map[string]any{"error": err.Error()})
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, effectiveApproversPath, noEffectiveApprovers)...)
+		return
+	}
+
+	tiers, problems, err := services.AnalyzeApprovers(ctx, resolver, &plan)
+	if err != nil {
+		tflog.Warn(ctx, "Could not resolve the approvers, skipping the approver check", map[string]any{"error": err.Error()})
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, effectiveApproversPath, noEffectiveApprovers)...)
+		return
+	}
+
+	for _, problem := range problems {
+		withPath, ok := problem.(diag.DiagnosticWithPath)
+		if !ok {
+			continue
+		}
+
+		if approverCheck.ValueString() == approverCheckError {
+			resp.Diagnostics.AddAttributeError(withPath.Path(), "Approver Check Failed", problem.Detail()+" Set approver_check to \"warn\" to apply anyway.")
+			continue
+		}
+		resp.Diagnostics.AddAttributeWarning(withPath.Path(), "Approver Check Failed", problem.Detail())
+	}
+
+	effectiveApprovers, diags := services.EffectiveApprovers(ctx, &plan, tiers)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, effectiveApproversPath, effectiveApprovers)...)
+}
+
+func requesterCannotApproveSelf(model *models.AccessFlowV2Model) types.Bool {
+	if model.Settings == nil {
+		return types.BoolNull()
+	}
+
+	return model.Settings.RequesterCannotApproveSelf
+}
+
+// keepApproverCheck copies approver_check and the planned effective_approvers to the state, since they aren't
+// returned by the API. effective_approvers is still unknown when the plan couldn't be analyzed.
+func keepApproverCheck(prior, current *models.AccessFlowV2Model) {
+	current.ApproverCheck = prior.ApproverCheck
+	if !prior.EffectiveApprovers.IsUnknown() && !prior.EffectiveApprovers.IsNull() {
+		current.EffectiveApprovers = prior.EffectiveApprovers
+	}
 }
 
 func (r *AponoAccessFlowV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.settings.CheckWriteAllowed("create access flow")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan models.AccessFlowV2Model
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -357,7 +774,7 @@ func (r *AponoAccessFlowV2Resource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	upsertRequest, err := models.AccessFlowModelToUpsertRequest(ctx, plan)
+	upsertRequest, err := models.AccessFlowModelToUpsertRequest(ctx, plan, r.settings.DefaultLabels)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating access flow",
@@ -375,7 +792,7 @@ func (r *AponoAccessFlowV2Resource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	accessFlowModel, err := models.AccessFlowResponseToModel(ctx, *accessFlow)
+	accessFlowModel, err := models.AccessFlowResponseToModel(ctx, *accessFlow, r.settings.DefaultLabels)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating access flow",
@@ -384,8 +801,13 @@ func (r *AponoAccessFlowV2Resource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	models.KeepConfiguredAccessFlowTargetReferences(&plan, accessFlowModel)
+	models.PreserveAccessFlowOrder(ctx, &plan, accessFlowModel)
+	keepApproverCheck(&plan, accessFlowModel)
+
 	diags = resp.State.Set(ctx, accessFlowModel)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, accessFlowModel.ID, accessFlowModel.Name)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -412,7 +834,7 @@ func (r *AponoAccessFlowV2Resource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	accessFlowModel, err := models.AccessFlowResponseToModel(ctx, *accessFlow)
+	accessFlowModel, err := models.AccessFlowResponseToModel(ctx, *accessFlow, r.settings.DefaultLabels)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading access flow",
@@ -421,14 +843,24 @@ func (r *AponoAccessFlowV2Resource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	models.KeepConfiguredAccessFlowTargetReferences(&state, accessFlowModel)
+	models.PreserveAccessFlowOrder(ctx, &state, accessFlowModel)
+	keepApproverCheck(&state, accessFlowModel)
+
 	diags = resp.State.Set(ctx, accessFlowModel)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, accessFlowModel.ID, accessFlowModel.Name)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
 func (r *AponoAccessFlowV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.settings.CheckWriteAllowed("update access flow")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan models.AccessFlowV2Model
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -436,7 +868,7 @@ func (r *AponoAccessFlowV2Resource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
-	upsertRequest, err := models.AccessFlowModelToUpsertRequest(ctx, plan)
+	upsertRequest, err := models.AccessFlowModelToUpsertRequest(ctx, plan, r.settings.DefaultLabels)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating access flow",
@@ -456,7 +888,7 @@ func (r *AponoAccessFlowV2Resource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
-	accessFlowModel, err := models.AccessFlowResponseToModel(ctx, *accessFlow)
+	accessFlowModel, err := models.AccessFlowResponseToModel(ctx, *accessFlow, r.settings.DefaultLabels)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating access flow",
@@ -465,14 +897,24 @@ func (r *AponoAccessFlowV2Resource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	models.KeepConfiguredAccessFlowTargetReferences(&plan, accessFlowModel)
+	models.PreserveAccessFlowOrder(ctx, &plan, accessFlowModel)
+	keepApproverCheck(&plan, accessFlowModel)
+
 	diags = resp.State.Set(ctx, accessFlowModel)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, accessFlowModel.ID, accessFlowModel.Name)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
 func (r *AponoAccessFlowV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.settings.CheckWriteAllowed("delete access flow")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state models.AccessFlowV2Model
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -494,6 +936,79 @@ func (r *AponoAccessFlowV2Resource) Delete(ctx context.Context, req resource.Del
 	}
 }
 
+// ImportState supports importing by ID or by name, using the "name:<access flow name>" import ID format,
+// or by the id of the resource identity.
 func (r *AponoAccessFlowV2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, diags := common.GetImportID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := services.ResolveImportID(importID, "access flow", func(name string) ([]string, error) {
+		accessFlows, err := services.ListAccessFlows(ctx, r.client)
+		if err != nil {
+			return nil, err
+		}
+
+		var ids []string
+		for _, accessFlow := range accessFlows {
+			if accessFlow.Name == name {
+				ids = append(ids, accessFlow.ID)
+			}
+		}
+
+		return ids, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing access flow", err.Error())
+		return
+	}
+
+	req.ID = id
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// MoveState supports `moved` blocks from apono_access_flow. Both resources share the same ID,
+// so only the ID is taken from the v1 state and the state is rebuilt by reading the object from the API by that ID.
+func (r *AponoAccessFlowV2Resource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				id, ok := common.GetMovedResourceID(req, resp, "apono_access_flow")
+				if !ok {
+					return
+				}
+
+				accessFlow, err := r.client.GetAccessFlowV2(ctx, client.GetAccessFlowV2Params{ID: id})
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Error moving access flow",
+						fmt.Sprintf("Unable to read access flow with ID %s, got error: %s", id, err),
+					)
+					return
+				}
+
+				accessFlowModel, err := models.AccessFlowResponseToModel(ctx, *accessFlow, r.settings.DefaultLabels)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Error moving access flow",
+						fmt.Sprintf("Unable to convert API response to model: %s", err),
+					)
+					return
+				}
+
+				models.KeepConfiguredAccessFlowTargetReferences(nil, accessFlowModel)
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, accessFlowModel)...)
+			},
+		},
+	}
+}
+
+// UpgradeState upgrades state written by earlier schema versions.
+func (r *AponoAccessFlowV2Resource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: common.StateUpgraderFromV0(r),
+	}
+}
@@ -2,16 +2,22 @@ package resources
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/mocks"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/testcommon"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -29,7 +35,7 @@ func TestAponoAccessFlowV2Resource(t *testing.T) {
 
 		ctx := t.Context()
 
-		model, err := models.AccessFlowResponseToModel(ctx, *mockResponse)
+		model, err := getTestAccessFlowModel(ctx, *mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model")
 
 		model.ID = types.StringNull()
@@ -74,7 +80,7 @@ func TestAponoAccessFlowV2Resource(t *testing.T) {
 		mockResponse := testcommon.GenerateAccessFlowResponse()
 		ctx := t.Context()
 
-		model, err := models.AccessFlowResponseToModel(ctx, *mockResponse)
+		model, err := getTestAccessFlowModel(ctx, *mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model")
 
 		mockInvoker.EXPECT().
@@ -109,6 +115,219 @@ func TestAponoAccessFlowV2Resource(t *testing.T) {
 		assert.Equal(t, state, got)
 	})
 
+	t.Run("ReadPreservesOrder", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r.client = mockInvoker
+
+		ctx := t.Context()
+
+		model, err := getTestAccessFlowModel(ctx, *testcommon.GenerateAccessFlowResponse())
+		require.NoError(t, err, "Failed to convert mock response to model")
+		state := *model
+
+		reordered := testcommon.GenerateAccessFlowResponse()
+		slices.Reverse(reordered.AccessTargets)
+		slices.Reverse(reordered.Requestors.Conditions[0].Values.Value)
+		mockInvoker.EXPECT().
+			GetAccessFlowV2(mock.Anything, mock.Anything).
+			Return(reordered, nil)
+
+		req := resource.ReadRequest{
+			State: tfsdk.State{
+				Schema: r.getTestSchema(ctx),
+			},
+		}
+		diags := req.State.Set(ctx, state)
+		require.False(t, diags.HasError(), "Error setting state: %s", diags.Errors())
+
+		resp := resource.ReadResponse{
+			State: tfsdk.State{
+				Schema: r.getTestSchema(ctx),
+				Raw:    req.State.Raw,
+			},
+		}
+
+		r.Read(ctx, req, &resp)
+
+		require.False(t, resp.Diagnostics.HasError(), "Read returned error: %s", resp.Diagnostics.Errors())
+
+		var got models.AccessFlowV2Model
+		diags = resp.State.Get(ctx, &got)
+		require.False(t, diags.HasError(), "Error getting state: %s", diags.Errors())
+
+		assert.Equal(t, state, got)
+	})
+
+	t.Run("ReadTargetReferencedByID", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r.client = mockInvoker
+
+		ctx := t.Context()
+
+		state, err := getTestAccessFlowModel(ctx, *testcommon.GenerateAccessFlowResponse())
+		require.NoError(t, err, "Failed to convert mock response to model")
+		state.AccessTargets[0].Bundle = &models.AccessFlowTargetBundleModel{
+			Name: types.StringNull(),
+			ID:   types.StringValue("bundle-123"),
+		}
+
+		renamed := testcommon.GenerateAccessFlowResponse()
+		bundle := renamed.AccessTargets[0].Bundle.Value
+		bundle.BundleName = "PROD ENV renamed"
+		renamed.AccessTargets[0].Bundle.SetTo(bundle)
+		mockInvoker.EXPECT().
+			GetAccessFlowV2(mock.Anything, mock.Anything).
+			Return(renamed, nil)
+
+		req := resource.ReadRequest{
+			State: tfsdk.State{
+				Schema: r.getTestSchema(ctx),
+			},
+		}
+		diags := req.State.Set(ctx, state)
+		require.False(t, diags.HasError(), "Error setting state: %s", diags.Errors())
+
+		resp := resource.ReadResponse{
+			State: tfsdk.State{
+				Schema: r.getTestSchema(ctx),
+				Raw:    req.State.Raw,
+			},
+		}
+
+		r.Read(ctx, req, &resp)
+
+		require.False(t, resp.Diagnostics.HasError(), "Read returned error: %s", resp.Diagnostics.Errors())
+
+		var got models.AccessFlowV2Model
+		diags = resp.State.Get(ctx, &got)
+		require.False(t, diags.HasError(), "Error getting state: %s", diags.Errors())
+
+		assert.Equal(t, *state, got)
+	})
+
+	validateConfig := func(t *testing.T, model *models.AccessFlowV2Model) diag.Diagnostics {
+		ctx := t.Context()
+
+		state := tfsdk.State{Schema: r.getTestSchema(ctx)}
+		diags := state.Set(ctx, model)
+		require.False(t, diags.HasError(), "Error setting config: %s", diags.Errors())
+
+		req := resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}
+		resp := resource.ValidateConfigResponse{}
+		r.ValidateConfig(ctx, req, &resp)
+		return resp.Diagnostics
+	}
+
+	t.Run("ValidateConfig", func(t *testing.T) {
+		model, err := getTestAccessFlowModel(t.Context(), *testcommon.GenerateAccessFlowResponse())
+		require.NoError(t, err)
+
+		diags := validateConfig(t, model)
+		assert.False(t, diags.HasError(), "ValidateConfig returned error: %s", diags.Errors())
+	})
+
+	t.Run("ValidateConfigAutomatic", func(t *testing.T) {
+		model, err := getTestAccessFlowModel(t.Context(), *testcommon.GenerateAccessFlowResponse())
+		require.NoError(t, err)
+		model.Trigger = types.StringValue("AUTOMATIC")
+		model.Settings.JustificationRequired = types.BoolValue(true)
+
+		diags := validateConfig(t, model)
+
+		var invalidPaths []string
+		for _, d := range diags.Errors() {
+			invalidPaths = append(invalidPaths, d.(diag.DiagnosticWithPath).Path().String())
+		}
+		assert.ElementsMatch(t, []string{"timeframe", "approver_policy", "escalation_policy", "request_for", "settings.justification_required"}, invalidPaths)
+
+		model.Timeframe = nil
+		model.ApproverPolicy = nil
+		model.EscalationPolicy = nil
+		model.RequestFor = nil
+		model.Settings.JustificationRequired = types.BoolValue(false)
+
+		diags = validateConfig(t, model)
+		assert.False(t, diags.HasError(), "ValidateConfig returned error: %s", diags.Errors())
+	})
+
+	t.Run("ValidateConfigAutomaticUnknownApproverPolicy", func(t *testing.T) {
+		ctx := t.Context()
+		model, err := getTestAccessFlowModel(ctx, *testcommon.GenerateAccessFlowResponse())
+		require.NoError(t, err)
+		model.Trigger = types.StringValue("AUTOMATIC")
+		model.Timeframe = nil
+		model.ApproverPolicy = nil
+		model.EscalationPolicy = nil
+		model.RequestFor = nil
+		model.Settings.JustificationRequired = types.BoolValue(false)
+
+		config := tfsdk.State{Schema: r.getTestSchema(ctx)}
+		diags := config.Set(ctx, model)
+		require.False(t, diags.HasError(), "Error setting config: %s", diags.Errors())
+
+		// e.g. approver_policy = var.needs_approval ? {...} : null
+		approverPolicyType := config.Schema.GetAttributes()["approver_policy"].GetType().(types.ObjectType)
+		diags = config.SetAttribute(ctx, path.Root("approver_policy"), types.ObjectUnknown(approverPolicyType.AttrTypes))
+		require.False(t, diags.HasError(), "Error setting config: %s", diags.Errors())
+
+		req := resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw}}
+		resp := resource.ValidateConfigResponse{}
+		r.ValidateConfig(ctx, req, &resp)
+		assert.False(t, resp.Diagnostics.HasError(), "ValidateConfig returned error: %s", resp.Diagnostics.Errors())
+	})
+
+	t.Run("ValidateConfigGranteesWithoutOthers", func(t *testing.T) {
+		model, err := getTestAccessFlowModel(t.Context(), *testcommon.GenerateAccessFlowResponse())
+		require.NoError(t, err)
+		model.RequestFor.RequestScopes = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("self")})
+
+		diags := validateConfig(t, model)
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "request_scopes")
+
+		model.RequestFor.RequestScopes = types.SetNull(types.StringType)
+
+		diags = validateConfig(t, model)
+		assert.True(t, diags.HasError())
+	})
+
+	t.Run("ValidateConfigExtensionDurationWithoutMaxExtensions", func(t *testing.T) {
+		model, err := getTestAccessFlowModel(t.Context(), *testcommon.GenerateAccessFlowResponse())
+		require.NoError(t, err)
+		model.Settings.MaxExtensions = types.Int32Null()
+		model.Settings.ExtensionDurationInMin = types.Int32Value(30)
+
+		diags := validateConfig(t, model)
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "max_extensions")
+
+		model.Settings.MaxExtensions = types.Int32Value(2)
+
+		diags = validateConfig(t, model)
+		assert.False(t, diags.HasError(), "ValidateConfig returned error: %s", diags.Errors())
+	})
+
+	t.Run("ValidateConfigTimeframe", func(t *testing.T) {
+		model, err := getTestAccessFlowModel(t.Context(), *testcommon.GenerateAccessFlowResponse())
+		require.NoError(t, err)
+		model.Timeframe.StartTime = types.StringValue("17:00")
+		model.Timeframe.EndTime = types.StringValue("08:00")
+
+		diags := validateConfig(t, model)
+		require.True(t, diags.HasError())
+		assert.Equal(t, "timeframe.end_time", diags.Errors()[0].(diag.DiagnosticWithPath).Path().String())
+
+		model.Timeframe.EndTime = types.StringValue("17:00")
+
+		diags = validateConfig(t, model)
+		assert.True(t, diags.HasError())
+
+		model.Timeframe.StartTime = types.StringValue("08:00")
+
+		diags = validateConfig(t, model)
+		assert.False(t, diags.HasError(), "ValidateConfig returned error: %s", diags.Errors())
+	})
+
 	t.Run("ReadNotFound", func(t *testing.T) {
 		mockInvoker := mocks.NewInvoker(t)
 		r.client = mockInvoker
@@ -121,7 +340,7 @@ func TestAponoAccessFlowV2Resource(t *testing.T) {
 			Return(nil, notFoundErr)
 
 		mockResponse := testcommon.GenerateAccessFlowResponse()
-		model, err := models.AccessFlowResponseToModel(ctx, *mockResponse)
+		model, err := getTestAccessFlowModel(ctx, *mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model")
 		state := *model
 
@@ -157,10 +376,10 @@ func TestAponoAccessFlowV2Resource(t *testing.T) {
 		updatedResponse := testcommon.GenerateAccessFlowResponse()
 		updatedResponse.Name = "updated-name"
 
-		planModel, err := models.AccessFlowResponseToModel(ctx, *updatedResponse)
+		planModel, err := getTestAccessFlowModel(ctx, *updatedResponse)
 		require.NoError(t, err, "Failed to convert updated response to model: %s", err)
 
-		stateModel, err := models.AccessFlowResponseToModel(ctx, *mockResponse)
+		stateModel, err := getTestAccessFlowModel(ctx, *mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model: %s", err)
 
 		mockInvoker.EXPECT().
@@ -207,7 +426,7 @@ func TestAponoAccessFlowV2Resource(t *testing.T) {
 
 		mockResponse := testcommon.GenerateAccessFlowResponse()
 
-		model, err := models.AccessFlowResponseToModel(ctx, *mockResponse)
+		model, err := getTestAccessFlowModel(ctx, *mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model: %s", err)
 
 		mockInvoker.EXPECT().
@@ -238,7 +457,7 @@ func TestAponoAccessFlowV2Resource(t *testing.T) {
 		notFoundErr := &client.NotFoundError{}
 
 		mockResponse := testcommon.GenerateAccessFlowResponse()
-		model, err := models.AccessFlowResponseToModel(ctx, *mockResponse)
+		model, err := getTestAccessFlowModel(ctx, *mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model: %s", err)
 
 		mockInvoker.EXPECT().
@@ -261,6 +480,36 @@ func TestAponoAccessFlowV2Resource(t *testing.T) {
 		assert.False(t, resp.Diagnostics.HasError())
 	})
 
+	t.Run("ReadOnly", func(t *testing.T) {
+		ctx := t.Context()
+		r := &AponoAccessFlowV2Resource{
+			client:   mocks.NewInvoker(t),
+			settings: common.ProviderSettings{ReadOnly: true},
+		}
+
+		model, err := getTestAccessFlowModel(ctx, *testcommon.GenerateAccessFlowResponse())
+		require.NoError(t, err)
+
+		plan := tfsdk.Plan{Schema: r.getTestSchema(ctx)}
+		diags := plan.Set(ctx, model)
+		require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+
+		state := tfsdk.State{Schema: r.getTestSchema(ctx), Raw: plan.Raw}
+
+		createResp := resource.CreateResponse{State: state}
+		r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+		require.True(t, createResp.Diagnostics.HasError())
+		assert.Equal(t, "Provider is read-only", createResp.Diagnostics.Errors()[0].Summary())
+
+		updateResp := resource.UpdateResponse{State: state}
+		r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, &updateResp)
+		assert.True(t, updateResp.Diagnostics.HasError())
+
+		deleteResp := resource.DeleteResponse{State: state}
+		r.Delete(ctx, resource.DeleteRequest{State: state}, &deleteResp)
+		assert.True(t, deleteResp.Diagnostics.HasError())
+	})
+
 	t.Run("ImportState", func(t *testing.T) {
 		mockInvoker := mocks.NewInvoker(t)
 		r.client = mockInvoker
@@ -268,7 +517,7 @@ func TestAponoAccessFlowV2Resource(t *testing.T) {
 		ctx := t.Context()
 
 		mockResponse := testcommon.GenerateAccessFlowResponse()
-		model, err := models.AccessFlowResponseToModel(ctx, *mockResponse)
+		model, err := getTestAccessFlowModel(ctx, *mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model: %s", err)
 
 		mockInvoker.EXPECT().
@@ -299,6 +548,238 @@ func TestAponoAccessFlowV2Resource(t *testing.T) {
 		require.False(t, diags.HasError())
 		assert.Equal(t, *model, imported)
 	})
+
+	t.Run("ModifyPlanDefaultLabels", func(t *testing.T) {
+		ctx := t.Context()
+		r := &AponoAccessFlowV2Resource{
+			settings: common.ProviderSettings{DefaultLabels: []string{"env:prod"}},
+		}
+
+		model, err := getTestAccessFlowModel(ctx, *testcommon.GenerateAccessFlowResponse())
+		require.NoError(t, err)
+		model.Settings.Labels = testcommon.CreateTestStringSet(t, []string{"team:security"})
+		model.Settings.LabelsAll = types.SetUnknown(types.StringType)
+
+		plan := tfsdk.Plan{Schema: r.getTestSchema(ctx)}
+		diags := plan.Set(ctx, model)
+		require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), "ModifyPlan returned error: %s", resp.Diagnostics.Errors())
+
+		var labelsAll []string
+		diags = resp.Plan.GetAttribute(ctx, path.Root("settings").AtName("labels_all"), &labelsAll)
+		require.False(t, diags.HasError())
+		assert.ElementsMatch(t, []string{"team:security", "env:prod"}, labelsAll)
+	})
+
+	t.Run("ModifyPlanDuplicateDefaultLabel", func(t *testing.T) {
+		ctx := t.Context()
+		r := &AponoAccessFlowV2Resource{
+			settings: common.ProviderSettings{DefaultLabels: []string{"env:prod"}},
+		}
+
+		model, err := getTestAccessFlowModel(ctx, *testcommon.GenerateAccessFlowResponse())
+		require.NoError(t, err)
+		model.Settings.Labels = testcommon.CreateTestStringSet(t, []string{"env:prod"})
+
+		plan := tfsdk.Plan{Schema: r.getTestSchema(ctx)}
+		diags := plan.Set(ctx, model)
+		require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, &resp)
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "env:prod")
+	})
+
+	t.Run("ModifyPlanReferences", func(t *testing.T) {
+		ctx := t.Context()
+		mockInvoker := mocks.NewInvoker(t)
+		r := &AponoAccessFlowV2Resource{client: mockInvoker}
+
+		model, err := getTestAccessFlowModel(ctx, *testcommon.GenerateAccessFlowResponse())
+		require.NoError(t, err)
+
+		resourceIntegrations := client.ListIntegrationsV4Params{}
+		resourceIntegrations.Category.SetTo([]string{common.ResourceCategory})
+		userInformationIntegrations := client.ListIntegrationsV4Params{}
+		userInformationIntegrations.Category.SetTo([]string{common.UserInformationCategory})
+
+		mockInvoker.EXPECT().ListIntegrationsV4(mock.Anything, resourceIntegrations).
+			Return(&client.PublicApiListResponseIntegrationPublicV4Model{Items: []client.IntegrationV4{{ID: "integration-123", Name: "postgresql"}}}, nil).Once()
+		mockInvoker.EXPECT().ListIntegrationsV4(mock.Anything, userInformationIntegrations).
+			Return(&client.PublicApiListResponseIntegrationPublicV4Model{Items: []client.IntegrationV4{{ID: "okta", Name: "Okta Directory"}, {ID: "google", Name: "Google OAuth"}}}, nil).Once()
+		permissionsParams := client.GetIntegrationPermissionsParams{ID: "integration-123"}
+		permissionsParams.ResourceType.SetTo("database")
+		mockInvoker.EXPECT().GetIntegrationPermissions(mock.Anything, permissionsParams).
+			Return(&client.PaginatedResponsePermissionV3Response{Data: []client.PermissionV3{
+				{ID: "permission-1", Name: "read", ResourceType: "database"},
+				{ID: "permission-2", Name: "writer", ResourceType: "database"},
+			}}, nil).Once()
+		mockInvoker.EXPECT().ListBundlesV2(mock.Anything, client.ListBundlesV2Params{}).
+			Return(&client.PublicApiListResponseBundlePublicV2Model{Items: []client.BundleV2{{ID: "bundle-123", Name: "PROD ENV"}}}, nil).Once()
+		mockInvoker.EXPECT().ListAccessScopesV1(mock.Anything, client.ListAccessScopesV1Params{}).
+			Return(&client.PublicApiListResponseAccessScopePublicV1Model{Items: []client.AccessScopeV1{{ID: "scope-456", Name: "Other Scope"}}}, nil).Once()
+
+		plan := tfsdk.Plan{Schema: r.getTestSchema(ctx)}
+		diags := plan.Set(ctx, model)
+		require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), "ModifyPlan returned error: %s", resp.Diagnostics.Errors())
+
+		var warningPaths []string
+		for _, d := range resp.Diagnostics.Warnings() {
+			warningPaths = append(warningPaths, d.(diag.DiagnosticWithPath).Path().String())
+		}
+		assert.ElementsMatch(t, []string{
+			"request_for.grantees.conditions[0].source_integration_name",
+			`access_targets[1].integration.permissions[Value("write")]`,
+			"access_targets[2].access_scope.name",
+		}, warningPaths)
+		assert.Contains(t, resp.Diagnostics.Warnings()[0].Detail(), `Did you mean "Google OAuth"?`)
+		assert.Contains(t, resp.Diagnostics.Warnings()[1].Detail(), `Did you mean "writer"?`)
+
+		// Unchanged references are not checked again.
+		state := tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}
+		resp = resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, &resp)
+		assert.Empty(t, resp.Diagnostics)
+	})
+
+	t.Run("ModifyPlanApproverCheck", func(t *testing.T) {
+		ctx := t.Context()
+		mockInvoker := mocks.NewInvoker(t)
+		r := &AponoAccessFlowV2Resource{client: mockInvoker}
+
+		model, err := getTestAccessFlowModel(ctx, *testcommon.GenerateAccessFlowResponse())
+		require.NoError(t, err)
+		model.Settings.RequesterCannotApproveSelf = types.BoolValue(true)
+
+		state := tfsdk.State{Schema: r.getTestSchema(ctx)}
+		diags := state.Set(ctx, model)
+		require.False(t, diags.HasError(), "Error setting state: %s", diags.Errors())
+
+		mockInvoker.EXPECT().ListUsersV3(mock.Anything, client.ListUsersV3Params{}).
+			Return(&client.PublicApiListResponseUserPublicV3Model{Items: []client.UserV3{
+				{ID: "user-1", Email: "person@example.com", Active: true, SourceIntegrationName: client.OptNilString{Value: "Okta Directory", Set: true}},
+			}}, nil).Once()
+		mockInvoker.EXPECT().ListGroupsV1(mock.Anything, client.ListGroupsV1Params{}).
+			Return(&client.PublicApiListResponseGroupPublicV1Model{}, nil).Once()
+
+		// Only approver_check changed, so the references aren't checked again.
+		model.ApproverCheck = types.StringValue(approverCheckError)
+		model.EffectiveApprovers = types.ListUnknown(models.EffectiveApproversTierType)
+		plan := tfsdk.Plan{Schema: state.Schema}
+		diags = plan.Set(ctx, model)
+		require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, &resp)
+
+		var errorPaths []string
+		for _, d := range resp.Diagnostics.Errors() {
+			errorPaths = append(errorPaths, d.(diag.DiagnosticWithPath).Path().String())
+			assert.Equal(t, "Approver Check Failed", d.Summary())
+			assert.Contains(t, d.Detail(), `Set approver_check to "warn" to apply anyway.`)
+		}
+		assert.ElementsMatch(t, []string{
+			"escalation_policy.approver_groups[0]",
+			"settings.requester_cannot_approve_self",
+		}, errorPaths)
+
+		var planned models.AccessFlowV2Model
+		diags = resp.Plan.Get(ctx, &planned)
+		require.False(t, diags.HasError(), "Error reading plan: %s", diags.Errors())
+		require.Len(t, planned.EffectiveApprovers.Elements(), 2)
+		assert.Equal(t, testcommon.CreateTestStringSet(t, []string{"person@example.com"}),
+			planned.EffectiveApprovers.Elements()[0].(types.Object).Attributes()["users"])
+
+		// Unchanged approvers are not analyzed again, and the prior analysis is kept.
+		state = tfsdk.State{Schema: plan.Schema, Raw: resp.Plan.Raw}
+		resp = resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, &resp)
+		assert.Empty(t, resp.Diagnostics)
+		diags = resp.Plan.Get(ctx, &planned)
+		require.False(t, diags.HasError())
+		assert.Len(t, planned.EffectiveApprovers.Elements(), 2)
+
+		// Without approver_check, effective_approvers isn't computed.
+		model.ApproverCheck = types.StringNull()
+		diags = plan.Set(ctx, model)
+		require.False(t, diags.HasError())
+		resp = resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, &resp)
+		assert.Empty(t, resp.Diagnostics)
+		diags = resp.Plan.Get(ctx, &planned)
+		require.False(t, diags.HasError())
+		assert.True(t, planned.EffectiveApprovers.IsNull())
+	})
+
+	t.Run("UpgradeStateFromV0", func(t *testing.T) {
+		ctx := t.Context()
+
+		// State written before v1.8.2, when access_targets and conditions were sets, and with an attribute that no longer exists
+		rawState := `{
+  "id": "flow-123",
+  "name": "Pre 1.8.2 flow",
+  "active": true,
+  "trigger": "SELF_SERVE",
+  "removed_attribute": "value",
+  "requestors": {
+    "logical_operator": "OR",
+    "conditions": [{"type": "user", "match_operator": "is", "values": ["a@example.com", "b@example.com"]}]
+  },
+  "access_targets": [
+    {"integration": {"integration_name": "postgresql", "resource_type": "postgresql-database", "permissions": ["READ_ONLY"], "resources_scopes": [{"scope_mode": "include_resources", "type": "NAME", "values": ["db1"]}]}},
+    {"access_scope": {"name": "Prod Scope"}}
+  ]
+}`
+
+		upgraders := r.UpgradeState(ctx)
+		require.Contains(t, upgraders, int64(0))
+		assert.Nil(t, upgraders[0].PriorSchema)
+
+		req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(rawState)}}
+		resp := resource.UpgradeStateResponse{State: tfsdk.State{Schema: r.getTestSchema(ctx)}}
+
+		upgraders[0].StateUpgrader(ctx, req, &resp)
+		require.False(t, resp.Diagnostics.HasError(), "UpgradeState returned error: %s", resp.Diagnostics.Errors())
+
+		var upgraded models.AccessFlowV2Model
+		diags := resp.State.Get(ctx, &upgraded)
+		require.False(t, diags.HasError(), "Error getting state: %s", diags.Errors())
+
+		assert.Equal(t, "flow-123", upgraded.ID.ValueString())
+		assert.Equal(t, "Pre 1.8.2 flow", upgraded.Name.ValueString())
+		assert.True(t, upgraded.Description.IsNull())
+		require.Len(t, upgraded.AccessTargets, 2)
+		require.Len(t, upgraded.AccessTargets[0].Integration.ResourcesScopes, 1)
+		assert.Equal(t, "Prod Scope", upgraded.AccessTargets[1].AccessScope.Name.ValueString())
+		require.Len(t, upgraded.Requestors.Conditions, 1)
+		assert.Len(t, upgraded.Requestors.Conditions[0].Values.Elements(), 2)
+	})
+
+	t.Run("UpgradeStateInvalidRawState", func(t *testing.T) {
+		ctx := t.Context()
+
+		req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(`{"access_targets": "invalid"}`)}}
+		resp := resource.UpgradeStateResponse{State: tfsdk.State{Schema: r.getTestSchema(ctx)}}
+
+		r.UpgradeState(ctx)[0].StateUpgrader(ctx, req, &resp)
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "Unable to Upgrade Resource State")
+	})
+}
+
+// getTestAccessFlowModel returns the model of the response as stored for a configuration referencing targets by name.
+func getTestAccessFlowModel(ctx context.Context, response client.AccessFlowV2) (*models.AccessFlowV2Model, error) {
+	model, err := models.AccessFlowResponseToModel(ctx, response, nil)
+	models.KeepConfiguredAccessFlowTargetReferences(nil, model)
+	return model, err
 }
 
 func (r *AponoAccessFlowV2Resource) getTestSchema(ctx context.Context) schema.Schema {
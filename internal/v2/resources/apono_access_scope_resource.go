@@ -5,35 +5,51 @@ import (
 	"fmt"
 
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/aql"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/services"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.ResourceWithConfigure   = &AponoAccessScopeResource{}
-	_ resource.ResourceWithImportState = &AponoAccessScopeResource{}
+	_ resource.ResourceWithConfigure      = &AponoAccessScopeResource{}
+	_ resource.ResourceWithImportState    = &AponoAccessScopeResource{}
+	_ resource.ResourceWithIdentity       = &AponoAccessScopeResource{}
+	_ resource.ResourceWithModifyPlan     = &AponoAccessScopeResource{}
+	_ resource.ResourceWithUpgradeState   = &AponoAccessScopeResource{}
+	_ resource.ResourceWithValidateConfig = &AponoAccessScopeResource{}
 )
 
+// accessScopeSchemaVersion is the schema version of apono_access_scope.
+const accessScopeSchemaVersion = 1
+
 func NewAponoAccessScopeResource() resource.Resource {
 	return &AponoAccessScopeResource{}
 }
 
 type AponoAccessScopeResource struct {
-	client client.Invoker
+	client   client.Invoker
+	settings common.ProviderSettings
 }
 
 func (r *AponoAccessScopeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_access_scope"
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *AponoAccessScopeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     accessScopeSchemaVersion,
 		Description: "Manages an Apono Access Scope, a logical grouping of cloud resources defined by a flexible query.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -52,19 +68,108 @@ func (r *AponoAccessScopeResource) Schema(_ context.Context, _ resource.SchemaRe
 				Optional:    true,
 			},
 			"query": schema.StringAttribute{
-				MarkdownDescription: "A query string written in [Apono Query Language](https://docs.apono.io/docs/inventory/apono-query-language).",
-				Required:            true,
+				MarkdownDescription: "A query string written in [Apono Query Language](https://docs.apono.io/docs/inventory/apono-query-language). Empty queries, unterminated strings and unbalanced parentheses or brackets are rejected at plan time. Syntax and fields unknown to the provider are reported as warnings. " +
+					"Exactly one of `query` or `filter` must be set. When `filter` is set, this is the query compiled from it.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					aql.QueryValidator(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("filter")),
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				MarkdownDescription: "A structured alternative to `query`, compiled into an AQL query by the provider. Each condition matches resources with any of its values. " +
+					"The conditions and groups are combined with `operator`, and the conditions of each group with the `operator` of the group.",
+				Optional:   true,
+				Attributes: accessScopeFilterAttributes(true),
+			},
+			"matched_integrations": schema.SetAttribute{
+				Description: "Names of the resource integrations whose resources the query can currently match, previewed in the plan when the query changes. " +
+					"It is evaluated from the integration, integration_type and resource_type conditions of the query. Conditions on other fields, such as tags, are not evaluated, so a matched integration may have no matching resources.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
 }
 
+func (r *AponoAccessScopeResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.GetResourceIdentitySchema("access scope")
+}
+
 func (r *AponoAccessScopeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	common.ConfigureResourceClientInvoker(ctx, req, resp, &r.client)
+	common.ConfigureResourceSettings(ctx, req, resp, &r.settings)
+}
+
+// ValidateConfig rejects filters that can't be compiled into a query, e.g. filters without conditions.
+func (r *AponoAccessScopeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var filter types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("filter"), &filter)...)
+	if resp.Diagnostics.HasError() || filter.IsNull() {
+		return
+	}
+
+	_, diags := services.AccessScopeFilterQuery(ctx, path.Root("filter"), filter)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ModifyPlan compiles the filter into the planned query, and previews the integrations matched by the query when
+// the access scope is created or its query changes.
+func (r *AponoAccessScopeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var filter types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("filter"), &filter)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !filter.IsNull() {
+		compiled, diags := services.AccessScopeFilterQuery(ctx, path.Root("filter"), filter)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("query"), compiled)...)
+	}
+
+	var query types.String
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("query"), &query)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateQuery types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("query"), &stateQuery)...)
+		if resp.Diagnostics.HasError() || stateQuery.Equal(query) {
+			return
+		}
+	}
+
+	matched := types.SetUnknown(types.StringType)
+	if !query.IsUnknown() && r.client != nil {
+		matched = r.matchedIntegrations(ctx, matched, query.ValueString())
+		if matched.IsNull() {
+			matched = types.SetUnknown(types.StringType)
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("matched_integrations"), matched)...)
 }
 
 func (r *AponoAccessScopeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan services.AccessScopeModel
+	resp.Diagnostics.Append(r.settings.CheckWriteAllowed("create access scope")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan services.AccessScopeResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -91,9 +196,12 @@ func (r *AponoAccessScopeResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	result := services.AccessScopeToModel(accessScope)
+	result := services.AccessScopeToResourceModel(accessScope)
+	result.Filter = plan.Filter
+	result.MatchedIntegrations = r.matchedIntegrations(ctx, plan.MatchedIntegrations, result.Query.ValueString())
 	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, result.ID, result.Name)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -102,7 +210,7 @@ func (r *AponoAccessScopeResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *AponoAccessScopeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state services.AccessScopeModel
+	var state services.AccessScopeResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -119,23 +227,38 @@ func (r *AponoAccessScopeResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	result := services.AccessScopeToModel(accessScope)
+	result := services.AccessScopeToResourceModel(accessScope)
+	result.Filter = state.Filter
+
+	// The preview is refreshed when the query was changed outside of Terraform or the state has none, e.g. after import.
+	previous := state.MatchedIntegrations
+	if state.Query.ValueString() != result.Query.ValueString() {
+		previous = types.SetUnknown(types.StringType)
+	}
+	result.MatchedIntegrations = r.matchedIntegrations(ctx, previous, result.Query.ValueString())
+
 	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, result.ID, result.Name)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
 func (r *AponoAccessScopeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state services.AccessScopeModel
+	resp.Diagnostics.Append(r.settings.CheckWriteAllowed("update access scope")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state services.AccessScopeResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var plan services.AccessScopeModel
+	var plan services.AccessScopeResourceModel
 	diags = req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -164,9 +287,12 @@ func (r *AponoAccessScopeResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	result := services.AccessScopeToModel(accessScope)
+	result := services.AccessScopeToResourceModel(accessScope)
+	result.Filter = plan.Filter
+	result.MatchedIntegrations = r.matchedIntegrations(ctx, plan.MatchedIntegrations, result.Query.ValueString())
 	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, result.ID, result.Name)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -175,7 +301,12 @@ func (r *AponoAccessScopeResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *AponoAccessScopeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state services.AccessScopeModel
+	resp.Diagnostics.Append(r.settings.CheckWriteAllowed("delete access scope")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state services.AccessScopeResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -194,6 +325,139 @@ func (r *AponoAccessScopeResource) Delete(ctx context.Context, req resource.Dele
 	tflog.Info(ctx, "Deleted access scope successfully", map[string]any{"id": state.ID.ValueString()})
 }
 
+// ImportState supports importing by ID or by name, using the "name:<access scope name>" import ID format,
+// or by the id of the resource identity.
 func (r *AponoAccessScopeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, diags := common.GetImportID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := services.ResolveImportID(importID, "access scope", func(name string) ([]string, error) {
+		accessScopes, err := services.ListAccessScopesByName(ctx, r.client, name)
+		if err != nil {
+			return nil, err
+		}
+
+		var ids []string
+		for _, accessScope := range accessScopes {
+			if accessScope.Name == name {
+				ids = append(ids, accessScope.ID)
+			}
+		}
+
+		return ids, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing access scope", err.Error())
+		return
+	}
+
+	req.ID = id
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// accessScopeFilterAttributes returns the attributes of an access scope filter, or of one of its groups when groups
+// is false, since groups can't be nested further.
+func accessScopeFilterAttributes(groups bool) map[string]schema.Attribute {
+	valuesValidators := []validator.Set{
+		setvalidator.SizeAtLeast(1),
+		setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+	}
+
+	attributes := map[string]schema.Attribute{
+		"operator": schema.StringAttribute{
+			MarkdownDescription: "How the conditions are combined, `and` or `or`. Defaults to `and`.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("and", "or"),
+			},
+		},
+		"integrations": schema.SetAttribute{
+			Description: "IDs or names of the integrations of the resources.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators:  valuesValidators,
+		},
+		"resource_types": schema.SetAttribute{
+			Description: "Resource types of the resources, e.g. aws-rds-mysql-database.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators:  valuesValidators,
+		},
+		"tags": schema.ListNestedAttribute{
+			Description: "Tags of the resources. Each tag matches resources with any of its values.",
+			Optional:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Description: "Tag key, e.g. env.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"values": schema.SetAttribute{
+						Description: "Tag values, e.g. [\"prod\"].",
+						ElementType: types.StringType,
+						Required:    true,
+						Validators:  valuesValidators,
+					},
+				},
+			},
+		},
+		"permissions": schema.SetAttribute{
+			Description: "Permissions of the resources.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators:  valuesValidators,
+		},
+		"resource_name_contains": schema.SetAttribute{
+			Description: "Substrings of the names of the resources.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators:  valuesValidators,
+		},
+	}
+
+	if groups {
+		attributes["groups"] = schema.ListNestedAttribute{
+			MarkdownDescription: "Groups of conditions, each combined with the `operator` of the group and added as a single condition of the filter, e.g. to match resources of either of two resource types with different tags. Groups are one level deep: a group has no `groups` of its own, so deeper nesting of `and` and `or` must be written as a `query`.",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: accessScopeFilterAttributes(false),
+			},
+		}
+	}
+
+	return attributes
+}
+
+// matchedIntegrations returns the known matched integrations, or evaluates them for the query. When the integrations
+// can't be listed, the preview is left empty rather than failing the operation.
+func (r *AponoAccessScopeResource) matchedIntegrations(ctx context.Context, known types.Set, query string) types.Set {
+	if !known.IsNull() && !known.IsUnknown() {
+		return known
+	}
+
+	names, err := services.MatchAccessScopeIntegrations(ctx, r.client, query)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to preview the integrations matched by the access scope query", map[string]any{"error": err.Error()})
+		return types.SetNull(types.StringType)
+	}
+
+	matched, diags := types.SetValueFrom(ctx, types.StringType, names)
+	if diags.HasError() {
+		return types.SetNull(types.StringType)
+	}
+
+	return matched
+}
+
+// UpgradeState upgrades state written by earlier schema versions.
+func (r *AponoAccessScopeResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: common.StateUpgraderFromV0(r),
+	}
+}
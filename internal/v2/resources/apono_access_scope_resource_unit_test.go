@@ -7,9 +7,11 @@ import (
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/mocks"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/services"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/testcommon"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -21,14 +23,30 @@ func TestAponoAccessScopeResource(t *testing.T) {
 	r := &AponoAccessScopeResource{client: mockInvoker}
 
 	getStateType := func() tftypes.Object {
-		return tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"id":          tftypes.String,
-				"name":        tftypes.String,
-				"description": tftypes.String,
-				"query":       tftypes.String,
-			},
+		return r.getTestSchema(t.Context()).Type().TerraformType(t.Context()).(tftypes.Object)
+	}
+
+	nullFilterValue := func() tftypes.Value {
+		return tftypes.NewValue(getStateType().AttributeTypes["filter"], nil)
+	}
+
+	matchedIntegrationsValue := func(names ...string) tftypes.Value {
+		var values []tftypes.Value
+		for _, name := range names {
+			values = append(values, tftypes.NewValue(tftypes.String, name))
 		}
+		return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, values)
+	}
+
+	expectListIntegrations := func() {
+		duck := client.IntegrationV4{ID: "integration-1", Name: "duck-pond"}
+		duck.ConnectedResourceTypes.SetTo([]string{"mock-duck"})
+		goose := client.IntegrationV4{ID: "integration-2", Name: "goose-pond"}
+		goose.ConnectedResourceTypes.SetTo([]string{"mock-goose"})
+
+		mockInvoker.EXPECT().ListIntegrationsV4(mock.Anything, mock.Anything).
+			Return(&client.PublicApiListResponseIntegrationPublicV4Model{Items: []client.IntegrationV4{duck, goose}}, nil).
+			Once()
 	}
 
 	t.Run("Create", func(t *testing.T) {
@@ -43,13 +61,17 @@ func TestAponoAccessScopeResource(t *testing.T) {
 			}, nil).
 			Once()
 
+		expectListIntegrations()
+
 		ctx := t.Context()
 		planType := getStateType()
 		planVal := tftypes.NewValue(planType, map[string]tftypes.Value{
-			"id":          tftypes.NewValue(tftypes.String, nil),
-			"name":        tftypes.NewValue(tftypes.String, "test-scope"),
-			"description": tftypes.NewValue(tftypes.String, nil),
-			"query":       tftypes.NewValue(tftypes.String, `resource_type = "mock-duck"`),
+			"id":                   tftypes.NewValue(tftypes.String, nil),
+			"name":                 tftypes.NewValue(tftypes.String, "test-scope"),
+			"description":          tftypes.NewValue(tftypes.String, nil),
+			"query":                tftypes.NewValue(tftypes.String, `resource_type = "mock-duck"`),
+			"filter":               nullFilterValue(),
+			"matched_integrations": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
 		})
 
 		schema := r.getTestSchema(ctx)
@@ -62,13 +84,14 @@ func TestAponoAccessScopeResource(t *testing.T) {
 		r.Create(ctx, req, &resp)
 
 		require.False(t, resp.Diagnostics.HasError())
-		var stateVal services.AccessScopeModel
+		var stateVal services.AccessScopeResourceModel
 		diags := resp.State.Get(ctx, &stateVal)
 		require.False(t, diags.HasError())
 
 		assert.Equal(t, "as-123456", stateVal.ID.ValueString())
 		assert.Equal(t, "test-scope", stateVal.Name.ValueString())
 		assert.Equal(t, `resource_type = "mock-duck"`, stateVal.Query.ValueString())
+		assert.Equal(t, testcommon.CreateTestStringSet(t, []string{"duck-pond"}), stateVal.MatchedIntegrations)
 	})
 
 	t.Run("Read", func(t *testing.T) {
@@ -83,13 +106,17 @@ func TestAponoAccessScopeResource(t *testing.T) {
 			}, nil).
 			Once()
 
+		expectListIntegrations()
+
 		ctx := t.Context()
 		stateType := getStateType()
 		stateVal := tftypes.NewValue(stateType, map[string]tftypes.Value{
-			"id":          tftypes.NewValue(tftypes.String, "as-123456"),
-			"name":        tftypes.NewValue(tftypes.String, "old-name"),
-			"description": tftypes.NewValue(tftypes.String, nil),
-			"query":       tftypes.NewValue(tftypes.String, `resource_type = "valid-resource"`),
+			"id":                   tftypes.NewValue(tftypes.String, "as-123456"),
+			"name":                 tftypes.NewValue(tftypes.String, "old-name"),
+			"description":          tftypes.NewValue(tftypes.String, nil),
+			"query":                tftypes.NewValue(tftypes.String, `resource_type = "valid-resource"`),
+			"filter":               nullFilterValue(),
+			"matched_integrations": matchedIntegrationsValue("goose-pond"),
 		})
 
 		schema := r.getTestSchema(ctx)
@@ -101,13 +128,14 @@ func TestAponoAccessScopeResource(t *testing.T) {
 		r.Read(ctx, req, &resp)
 
 		require.False(t, resp.Diagnostics.HasError())
-		var stateModel services.AccessScopeModel
+		var stateModel services.AccessScopeResourceModel
 		diags := resp.State.Get(ctx, &stateModel)
 		require.False(t, diags.HasError())
 
 		assert.Equal(t, "as-123456", stateModel.ID.ValueString())
 		assert.Equal(t, "test-scope", stateModel.Name.ValueString())
 		assert.Equal(t, `resource_type = "mock-duck"`, stateModel.Query.ValueString())
+		assert.Equal(t, testcommon.CreateTestStringSet(t, []string{"duck-pond"}), stateModel.MatchedIntegrations, "the preview is refreshed when the query changed")
 	})
 
 	t.Run("Read_NotFound", func(t *testing.T) {
@@ -122,10 +150,12 @@ func TestAponoAccessScopeResource(t *testing.T) {
 		ctx := t.Context()
 		stateType := getStateType()
 		stateVal := tftypes.NewValue(stateType, map[string]tftypes.Value{
-			"id":          tftypes.NewValue(tftypes.String, "as-not-found"),
-			"name":        tftypes.NewValue(tftypes.String, "test-scope"),
-			"description": tftypes.NewValue(tftypes.String, nil),
-			"query":       tftypes.NewValue(tftypes.String, `resource_type = "mock-duck"`),
+			"id":                   tftypes.NewValue(tftypes.String, "as-not-found"),
+			"name":                 tftypes.NewValue(tftypes.String, "test-scope"),
+			"description":          tftypes.NewValue(tftypes.String, nil),
+			"query":                tftypes.NewValue(tftypes.String, `resource_type = "mock-duck"`),
+			"filter":               nullFilterValue(),
+			"matched_integrations": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 		})
 
 		schema := r.getTestSchema(ctx)
@@ -152,6 +182,8 @@ func TestAponoAccessScopeResource(t *testing.T) {
 			}, nil).
 			Times(1)
 
+		expectListIntegrations()
+
 		ctx := t.Context()
 		req := resource.ImportStateRequest{ID: "as-import-id"}
 
@@ -170,13 +202,14 @@ func TestAponoAccessScopeResource(t *testing.T) {
 		r.Read(ctx, readReq, &readResp)
 
 		require.False(t, readResp.Diagnostics.HasError())
-		var stateModel services.AccessScopeModel
+		var stateModel services.AccessScopeResourceModel
 		diags := readResp.State.Get(ctx, &stateModel)
 		require.False(t, diags.HasError())
 
 		assert.Equal(t, "as-import-id", stateModel.ID.ValueString())
 		assert.Equal(t, "imported-scope", stateModel.Name.ValueString())
 		assert.Equal(t, `resource_type = "mock-duck"`, stateModel.Query.ValueString())
+		assert.Equal(t, testcommon.CreateTestStringSet(t, []string{"duck-pond"}), stateModel.MatchedIntegrations)
 	})
 
 	t.Run("Update_ClearsDescription", func(t *testing.T) {
@@ -200,16 +233,20 @@ func TestAponoAccessScopeResource(t *testing.T) {
 		stateType := getStateType()
 
 		stateVal := tftypes.NewValue(stateType, map[string]tftypes.Value{
-			"id":          tftypes.NewValue(tftypes.String, "as-123456"),
-			"name":        tftypes.NewValue(tftypes.String, "test-scope"),
-			"description": tftypes.NewValue(tftypes.String, "old description"),
-			"query":       tftypes.NewValue(tftypes.String, `resource_type = "mock-duck"`),
+			"id":                   tftypes.NewValue(tftypes.String, "as-123456"),
+			"name":                 tftypes.NewValue(tftypes.String, "test-scope"),
+			"description":          tftypes.NewValue(tftypes.String, "old description"),
+			"query":                tftypes.NewValue(tftypes.String, `resource_type = "mock-duck"`),
+			"filter":               nullFilterValue(),
+			"matched_integrations": matchedIntegrationsValue("duck-pond"),
 		})
 		planVal := tftypes.NewValue(stateType, map[string]tftypes.Value{
-			"id":          tftypes.NewValue(tftypes.String, "as-123456"),
-			"name":        tftypes.NewValue(tftypes.String, "test-scope"),
-			"description": tftypes.NewValue(tftypes.String, nil),
-			"query":       tftypes.NewValue(tftypes.String, `resource_type = "mock-duck"`),
+			"id":                   tftypes.NewValue(tftypes.String, "as-123456"),
+			"name":                 tftypes.NewValue(tftypes.String, "test-scope"),
+			"description":          tftypes.NewValue(tftypes.String, nil),
+			"query":                tftypes.NewValue(tftypes.String, `resource_type = "mock-duck"`),
+			"filter":               nullFilterValue(),
+			"matched_integrations": matchedIntegrationsValue("duck-pond"),
 		})
 
 		schema := r.getTestSchema(ctx)
@@ -224,11 +261,128 @@ func TestAponoAccessScopeResource(t *testing.T) {
 		r.Update(ctx, req, &resp)
 
 		require.False(t, resp.Diagnostics.HasError())
-		var stateModel services.AccessScopeModel
+		var stateModel services.AccessScopeResourceModel
 		diags := resp.State.Get(ctx, &stateModel)
 		require.False(t, diags.HasError())
 		assert.True(t, stateModel.Description.IsNull())
 	})
+
+	t.Run("ModifyPlanPreviewsMatchedIntegrations", func(t *testing.T) {
+		expectListIntegrations()
+
+		ctx := t.Context()
+		stateType := getStateType()
+		schema := r.getTestSchema(ctx)
+
+		stateVal := tftypes.NewValue(stateType, map[string]tftypes.Value{
+			"id":                   tftypes.NewValue(tftypes.String, "as-123456"),
+			"name":                 tftypes.NewValue(tftypes.String, "test-scope"),
+			"description":          tftypes.NewValue(tftypes.String, nil),
+			"query":                tftypes.NewValue(tftypes.String, `resource_type = "mock-duck"`),
+			"filter":               nullFilterValue(),
+			"matched_integrations": matchedIntegrationsValue("duck-pond"),
+		})
+		planVal := tftypes.NewValue(stateType, map[string]tftypes.Value{
+			"id":                   tftypes.NewValue(tftypes.String, "as-123456"),
+			"name":                 tftypes.NewValue(tftypes.String, "test-scope"),
+			"description":          tftypes.NewValue(tftypes.String, nil),
+			"query":                tftypes.NewValue(tftypes.String, `resource_type in ("mock-duck", "mock-goose")`),
+			"filter":               nullFilterValue(),
+			"matched_integrations": matchedIntegrationsValue("duck-pond"),
+		})
+
+		state := tfsdk.State{Schema: schema, Raw: stateVal}
+		plan := tfsdk.Plan{Schema: schema, Raw: planVal}
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, &resp)
+		require.False(t, resp.Diagnostics.HasError())
+
+		var planModel services.AccessScopeResourceModel
+		diags := resp.Plan.Get(ctx, &planModel)
+		require.False(t, diags.HasError())
+		assert.Equal(t, testcommon.CreateTestStringSet(t, []string{"duck-pond", "goose-pond"}), planModel.MatchedIntegrations)
+
+		// An unchanged query keeps the preview of the state without listing the integrations.
+		plan = tfsdk.Plan{Schema: schema, Raw: stateVal}
+		resp = resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, &resp)
+		require.False(t, resp.Diagnostics.HasError())
+		assert.True(t, resp.Plan.Raw.Equal(stateVal))
+	})
+
+	filterModel := func() *services.AccessScopeFilterModel {
+		return &services.AccessScopeFilterModel{
+			AccessScopeFilterGroupModel: services.AccessScopeFilterGroupModel{
+				Operator:             types.StringNull(),
+				Integrations:         types.SetNull(types.StringType),
+				ResourceTypes:        testcommon.CreateTestStringSet(t, []string{"mock-goose", "mock-duck"}),
+				Tags:                 []services.AccessScopeTagModel{{Key: types.StringValue("env"), Values: testcommon.CreateTestStringSet(t, []string{"prod"})}},
+				Permissions:          types.SetNull(types.StringType),
+				ResourceNameContains: types.SetNull(types.StringType),
+			},
+		}
+	}
+
+	t.Run("ModifyPlanCompilesFilter", func(t *testing.T) {
+		expectListIntegrations()
+
+		ctx := t.Context()
+		schema := r.getTestSchema(ctx)
+
+		model := services.AccessScopeResourceModel{
+			AccessScopeModel: services.AccessScopeModel{
+				ID:          types.StringUnknown(),
+				Name:        types.StringValue("test-scope"),
+				Description: types.StringNull(),
+				Query:       types.StringUnknown(),
+			},
+			Filter:              filterModel(),
+			MatchedIntegrations: types.SetUnknown(types.StringType),
+		}
+
+		plan := tfsdk.Plan{Schema: schema}
+		diags := plan.Set(ctx, model)
+		require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: tfsdk.State{Schema: schema, Raw: tftypes.NewValue(getStateType(), nil)}}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), "ModifyPlan returned error: %s", resp.Diagnostics.Errors())
+
+		var planModel services.AccessScopeResourceModel
+		diags = resp.Plan.Get(ctx, &planModel)
+		require.False(t, diags.HasError())
+		assert.Equal(t, `resource_type in ("mock-duck", "mock-goose") and resource_tag["env"] = "prod"`, planModel.Query.ValueString())
+		assert.Equal(t, testcommon.CreateTestStringSet(t, []string{"duck-pond", "goose-pond"}), planModel.MatchedIntegrations)
+	})
+
+	t.Run("ValidateConfigEmptyFilter", func(t *testing.T) {
+		ctx := t.Context()
+		schema := r.getTestSchema(ctx)
+
+		filter := filterModel()
+		filter.ResourceTypes = types.SetNull(types.StringType)
+		filter.Tags = nil
+
+		config := tfsdk.Config{Schema: schema}
+		plan := tfsdk.Plan{Schema: schema}
+		diags := plan.Set(ctx, services.AccessScopeResourceModel{
+			AccessScopeModel: services.AccessScopeModel{
+				ID:          types.StringNull(),
+				Name:        types.StringValue("test-scope"),
+				Description: types.StringNull(),
+				Query:       types.StringNull(),
+			},
+			Filter:              filter,
+			MatchedIntegrations: types.SetNull(types.StringType),
+		})
+		require.False(t, diags.HasError(), "Error setting config: %s", diags.Errors())
+		config.Raw = plan.Raw
+
+		resp := resource.ValidateConfigResponse{}
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "at least one condition")
+	})
 }
 
 func (r *AponoAccessScopeResource) getTestSchema(ctx context.Context) schema.Schema {
@@ -3,11 +3,13 @@ package resources
 import (
 	"context"
 	"fmt"
+	"reflect"
 
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/schemas"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/services"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,24 +18,34 @@ import (
 )
 
 var (
-	_ resource.ResourceWithConfigure   = &AponoBundleV2Resource{}
-	_ resource.ResourceWithImportState = &AponoBundleV2Resource{}
+	_ resource.ResourceWithConfigure    = &AponoBundleV2Resource{}
+	_ resource.ResourceWithImportState  = &AponoBundleV2Resource{}
+	_ resource.ResourceWithModifyPlan   = &AponoBundleV2Resource{}
+	_ resource.ResourceWithIdentity     = &AponoBundleV2Resource{}
+	_ resource.ResourceWithMoveState    = &AponoBundleV2Resource{}
+	_ resource.ResourceWithUpgradeState = &AponoBundleV2Resource{}
 )
 
+// bundleV2SchemaVersion is the schema version of apono_bundle_v2.
+const bundleV2SchemaVersion = 1
+
 func NewAponoBundleV2Resource() resource.Resource {
 	return &AponoBundleV2Resource{}
 }
 
 type AponoBundleV2Resource struct {
-	client client.Invoker
+	client   client.Invoker
+	settings common.ProviderSettings
 }
 
 func (r *AponoBundleV2Resource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_bundle_v2"
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *AponoBundleV2Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     bundleV2SchemaVersion,
 		Description: "Manages an Apono Bundle, which defines a collection of access targets - either access scopes or specific resources within integrations.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -61,11 +73,49 @@ func (r *AponoBundleV2Resource) Schema(_ context.Context, _ resource.SchemaReque
 	}
 }
 
+func (r *AponoBundleV2Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.GetResourceIdentitySchema("bundle")
+}
+
 func (r *AponoBundleV2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	common.ConfigureResourceClientInvoker(ctx, req, resp, &r.client)
+	common.ConfigureResourceSettings(ctx, req, resp, &r.settings)
+}
+
+// ModifyPlan warns about access targets that don't exist in the tenant. It only lists the tenant when they are
+// created or changed.
+func (r *AponoBundleV2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	// Plans with unknown nested objects can't be read into the model, and are checked once they are known.
+	var plan models.BundleV2Model
+	if req.Plan.Get(ctx, &plan).HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state models.BundleV2Model
+		if !req.State.Get(ctx, &state).HasError() && reflect.DeepEqual(plan.AccessTargets, state.AccessTargets) {
+			return
+		}
+	}
+
+	checker := services.NewReferenceChecker(r.client)
+	for i, target := range plan.AccessTargets {
+		targetPath := path.Root("access_targets").AtListIndex(i)
+		resp.Diagnostics.Append(checker.CheckIntegrationTarget(ctx, targetPath.AtName("integration"), target.Integration)...)
+		resp.Diagnostics.Append(checker.CheckAccessScopeTarget(ctx, targetPath.AtName("access_scope"), target.AccessScope)...)
+	}
 }
 
 func (r *AponoBundleV2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.settings.CheckWriteAllowed("create bundle")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan models.BundleV2Model
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -100,8 +150,11 @@ func (r *AponoBundleV2Resource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	models.KeepConfiguredBundleTargetReferences(&plan, bundleModel)
+
 	diags = resp.State.Set(ctx, bundleModel)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, bundleModel.ID, bundleModel.Name)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -136,14 +189,22 @@ func (r *AponoBundleV2Resource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	models.KeepConfiguredBundleTargetReferences(&state, bundleModel)
+
 	diags = resp.State.Set(ctx, bundleModel)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, bundleModel.ID, bundleModel.Name)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
 func (r *AponoBundleV2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.settings.CheckWriteAllowed("update bundle")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan models.BundleV2Model
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -180,14 +241,22 @@ func (r *AponoBundleV2Resource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	models.KeepConfiguredBundleTargetReferences(&plan, bundleModel)
+
 	diags = resp.State.Set(ctx, bundleModel)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, bundleModel.ID, bundleModel.Name)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
 func (r *AponoBundleV2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.settings.CheckWriteAllowed("delete bundle")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state models.BundleV2Model
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -208,6 +277,79 @@ func (r *AponoBundleV2Resource) Delete(ctx context.Context, req resource.DeleteR
 	}
 }
 
+// ImportState supports importing by ID or by name, using the "name:<bundle name>" import ID format,
+// or by the id of the resource identity.
 func (r *AponoBundleV2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, diags := common.GetImportID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := services.ResolveImportID(importID, "bundle", func(name string) ([]string, error) {
+		bundles, err := services.ListBundles(ctx, r.client, name)
+		if err != nil {
+			return nil, err
+		}
+
+		var ids []string
+		for _, bundle := range bundles {
+			if bundle.Name == name {
+				ids = append(ids, bundle.ID)
+			}
+		}
+
+		return ids, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing bundle", err.Error())
+		return
+	}
+
+	req.ID = id
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// MoveState supports `moved` blocks from apono_access_bundle. Both resources share the same ID,
+// so only the ID is taken from the v1 state and the state is rebuilt by reading the object from the API by that ID.
+func (r *AponoBundleV2Resource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				id, ok := common.GetMovedResourceID(req, resp, "apono_access_bundle")
+				if !ok {
+					return
+				}
+
+				bundle, err := r.client.GetBundleV2(ctx, client.GetBundleV2Params{ID: id})
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Error moving bundle",
+						fmt.Sprintf("Unable to read bundle with ID %s, got error: %s", id, err),
+					)
+					return
+				}
+
+				bundleModel, err := models.BundleResponseToModel(ctx, *bundle)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Error moving bundle",
+						fmt.Sprintf("Unable to convert API response to model: %s", err),
+					)
+					return
+				}
+
+				models.KeepConfiguredBundleTargetReferences(nil, bundleModel)
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, bundleModel)...)
+			},
+		},
+	}
+}
+
+// UpgradeState upgrades state written by earlier schema versions.
+func (r *AponoBundleV2Resource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: common.StateUpgraderFromV0(r),
+	}
+}
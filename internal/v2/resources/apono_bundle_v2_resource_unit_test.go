@@ -6,9 +6,13 @@ import (
 
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/mocks"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/testcommon"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -29,7 +33,7 @@ func TestAponoBundleV2Resource(t *testing.T) {
 
 		ctx := t.Context()
 
-		model, err := models.BundleResponseToModel(ctx, *mockResponse)
+		model, err := getTestBundleModel(ctx, *mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model")
 
 		model.ID = types.StringNull()
@@ -74,7 +78,7 @@ func TestAponoBundleV2Resource(t *testing.T) {
 		mockResponse := testcommon.GenerateBundleResponse()
 		ctx := t.Context()
 
-		model, err := models.BundleResponseToModel(ctx, *mockResponse)
+		model, err := getTestBundleModel(ctx, *mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model")
 
 		mockInvoker.EXPECT().
@@ -121,7 +125,7 @@ func TestAponoBundleV2Resource(t *testing.T) {
 			Return(nil, notFoundErr)
 
 		mockResponse := testcommon.GenerateBundleResponse()
-		model, err := models.BundleResponseToModel(ctx, *mockResponse)
+		model, err := getTestBundleModel(ctx, *mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model")
 		state := *model
 
@@ -157,10 +161,10 @@ func TestAponoBundleV2Resource(t *testing.T) {
 		updatedResponse := testcommon.GenerateBundleResponse()
 		updatedResponse.Name = "updated-bundle-name"
 
-		planModel, err := models.BundleResponseToModel(ctx, *updatedResponse)
+		planModel, err := getTestBundleModel(ctx, *updatedResponse)
 		require.NoError(t, err, "Failed to convert updated response to model: %s", err)
 
-		stateModel, err := models.BundleResponseToModel(ctx, *mockResponse)
+		stateModel, err := getTestBundleModel(ctx, *mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model: %s", err)
 
 		mockInvoker.EXPECT().
@@ -207,7 +211,7 @@ func TestAponoBundleV2Resource(t *testing.T) {
 
 		mockResponse := testcommon.GenerateBundleResponse()
 
-		model, err := models.BundleResponseToModel(ctx, *mockResponse)
+		model, err := getTestBundleModel(ctx, *mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model: %s", err)
 
 		mockInvoker.EXPECT().
@@ -238,7 +242,7 @@ func TestAponoBundleV2Resource(t *testing.T) {
 		notFoundErr := &client.NotFoundError{}
 
 		mockResponse := testcommon.GenerateBundleResponse()
-		model, err := models.BundleResponseToModel(ctx, *mockResponse)
+		model, err := getTestBundleModel(ctx, *mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model: %s", err)
 
 		mockInvoker.EXPECT().
@@ -268,7 +272,7 @@ func TestAponoBundleV2Resource(t *testing.T) {
 		ctx := t.Context()
 
 		mockResponse := testcommon.GenerateBundleResponse()
-		model, err := models.BundleResponseToModel(ctx, *mockResponse)
+		model, err := getTestBundleModel(ctx, *mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model: %s", err)
 
 		mockInvoker.EXPECT().
@@ -299,6 +303,173 @@ func TestAponoBundleV2Resource(t *testing.T) {
 		require.False(t, diags.HasError())
 		assert.Equal(t, *model, imported)
 	})
+
+	t.Run("ImportStateByName", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r.client = mockInvoker
+
+		ctx := t.Context()
+
+		mockResponse := testcommon.GenerateBundleResponse()
+		params := client.ListBundlesV2Params{}
+		params.Name.SetTo(mockResponse.Name)
+
+		mockInvoker.EXPECT().
+			ListBundlesV2(mock.Anything, params).
+			Return(&client.PublicApiListResponseBundlePublicV2Model{
+				Items: []client.BundleV2{*mockResponse, {ID: "other", Name: mockResponse.Name + " copy"}},
+			}, nil)
+
+		resp := resource.ImportStateResponse{
+			State: tfsdk.State{
+				Schema: r.getTestSchema(ctx),
+				Raw:    tftypes.NewValue(r.getTestSchema(ctx).Type().TerraformType(ctx), nil),
+			},
+		}
+
+		r.ImportState(ctx, resource.ImportStateRequest{ID: "name:" + mockResponse.Name}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), "ImportState returned error: %s", resp.Diagnostics.Errors())
+
+		var id types.String
+		diags := resp.State.GetAttribute(ctx, path.Root("id"), &id)
+		require.False(t, diags.HasError())
+		assert.Equal(t, mockResponse.ID, id.ValueString())
+	})
+
+	t.Run("ImportStateByNameNotFound", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r.client = mockInvoker
+
+		ctx := t.Context()
+
+		mockInvoker.EXPECT().
+			ListBundlesV2(mock.Anything, mock.Anything).
+			Return(&client.PublicApiListResponseBundlePublicV2Model{}, nil)
+
+		resp := resource.ImportStateResponse{
+			State: tfsdk.State{
+				Schema: r.getTestSchema(ctx),
+				Raw:    tftypes.NewValue(r.getTestSchema(ctx).Type().TerraformType(ctx), nil),
+			},
+		}
+
+		r.ImportState(ctx, resource.ImportStateRequest{ID: "name:missing"}, &resp)
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "bundle with name 'missing' not found")
+	})
+
+	t.Run("ReadSetsIdentity", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r.client = mockInvoker
+
+		mockResponse := testcommon.GenerateBundleResponse()
+		ctx := t.Context()
+
+		model, err := getTestBundleModel(ctx, *mockResponse)
+		require.NoError(t, err, "Failed to convert mock response to model")
+
+		mockInvoker.EXPECT().
+			GetBundleV2(mock.Anything, mock.Anything).
+			Return(mockResponse, nil)
+
+		req := resource.ReadRequest{
+			State: tfsdk.State{
+				Schema: r.getTestSchema(ctx),
+			},
+		}
+		diags := req.State.Set(ctx, *model)
+		require.False(t, diags.HasError(), "Error setting state: %s", diags.Errors())
+
+		identitySchema := r.getTestIdentitySchema(ctx)
+		resp := resource.ReadResponse{
+			State: tfsdk.State{
+				Schema: r.getTestSchema(ctx),
+				Raw:    req.State.Raw,
+			},
+			Identity: &tfsdk.ResourceIdentity{
+				Schema: identitySchema,
+				Raw:    tftypes.NewValue(identitySchema.Type().TerraformType(ctx), nil),
+			},
+		}
+
+		r.Read(ctx, req, &resp)
+		require.False(t, resp.Diagnostics.HasError(), "Read returned error: %s", resp.Diagnostics.Errors())
+
+		var identity common.ResourceIdentityModel
+		diags = resp.Identity.Get(ctx, &identity)
+		require.False(t, diags.HasError(), "Error getting identity: %s", diags.Errors())
+
+		assert.Equal(t, mockResponse.ID, identity.ID.ValueString())
+		assert.Equal(t, mockResponse.Name, identity.Name.ValueString())
+	})
+
+	t.Run("ImportStateByIdentity", func(t *testing.T) {
+		ctx := t.Context()
+
+		identitySchema := r.getTestIdentitySchema(ctx)
+		identity := tfsdk.ResourceIdentity{
+			Schema: identitySchema,
+			Raw:    tftypes.NewValue(identitySchema.Type().TerraformType(ctx), nil),
+		}
+		diags := identity.Set(ctx, common.ResourceIdentityModel{
+			ID:   types.StringValue("bundle-123"),
+			Name: types.StringNull(),
+		})
+		require.False(t, diags.HasError(), "Error setting identity: %s", diags.Errors())
+
+		resp := resource.ImportStateResponse{
+			State: tfsdk.State{
+				Schema: r.getTestSchema(ctx),
+				Raw:    tftypes.NewValue(r.getTestSchema(ctx).Type().TerraformType(ctx), nil),
+			},
+		}
+
+		r.ImportState(ctx, resource.ImportStateRequest{Identity: &identity}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), "ImportState returned error: %s", resp.Diagnostics.Errors())
+
+		var id types.String
+		diags = resp.State.GetAttribute(ctx, path.Root("id"), &id)
+		require.False(t, diags.HasError())
+		assert.Equal(t, "bundle-123", id.ValueString())
+	})
+
+	t.Run("ModifyPlanReferences", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r.client = mockInvoker
+
+		ctx := t.Context()
+
+		model, err := getTestBundleModel(ctx, *testcommon.GenerateBundleResponse())
+		require.NoError(t, err)
+
+		integrationParams := client.ListIntegrationsV4Params{}
+		integrationParams.Category.SetTo([]string{common.ResourceCategory})
+		postgres := client.IntegrationV4{ID: "integration-123", Name: "postgresql"}
+		postgres.ConnectedResourceTypes.SetTo([]string{"postgresql-database"})
+
+		mockInvoker.EXPECT().ListIntegrationsV4(mock.Anything, integrationParams).
+			Return(&client.PublicApiListResponseIntegrationPublicV4Model{Items: []client.IntegrationV4{postgres}}, nil).Once()
+		mockInvoker.EXPECT().ListAccessScopesV1(mock.Anything, client.ListAccessScopesV1Params{}).
+			Return(&client.PublicApiListResponseAccessScopePublicV1Model{Items: []client.AccessScopeV1{{ID: "scope-123", Name: "Test Scope"}}}, nil).Once()
+
+		plan := tfsdk.Plan{Schema: r.getTestSchema(ctx)}
+		diags := plan.Set(ctx, model)
+		require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), "ModifyPlan returned error: %s", resp.Diagnostics.Errors())
+		require.Len(t, resp.Diagnostics.Warnings(), 1)
+		assert.Equal(t, path.Root("access_targets").AtListIndex(0).AtName("integration").AtName("resource_type"),
+			resp.Diagnostics.Warnings()[0].(diag.DiagnosticWithPath).Path())
+	})
+}
+
+// getTestBundleModel returns the model of the response as stored for a configuration referencing targets by name.
+func getTestBundleModel(ctx context.Context, response client.BundleV2) (*models.BundleV2Model, error) {
+	model, err := models.BundleResponseToModel(ctx, response)
+	models.KeepConfiguredBundleTargetReferences(nil, model)
+	return model, err
 }
 
 func (r *AponoBundleV2Resource) getTestSchema(ctx context.Context) schema.Schema {
@@ -306,3 +477,9 @@ func (r *AponoBundleV2Resource) getTestSchema(ctx context.Context) schema.Schema
 	r.Schema(ctx, resource.SchemaRequest{}, &resp)
 	return resp.Schema
 }
+
+func (r *AponoBundleV2Resource) getTestIdentitySchema(ctx context.Context) identityschema.Schema {
+	var resp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &resp)
+	return resp.IdentitySchema
+}
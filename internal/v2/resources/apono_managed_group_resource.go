@@ -3,40 +3,61 @@ package resources
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/services"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.ResourceWithConfigure   = &AponoManagedGroupResource{}
-	_ resource.ResourceWithImportState = &AponoManagedGroupResource{}
+	_ resource.ResourceWithConfigure      = &AponoManagedGroupResource{}
+	_ resource.ResourceWithImportState    = &AponoManagedGroupResource{}
+	_ resource.ResourceWithIdentity       = &AponoManagedGroupResource{}
+	_ resource.ResourceWithUpgradeState   = &AponoManagedGroupResource{}
+	_ resource.ResourceWithValidateConfig = &AponoManagedGroupResource{}
+	_ resource.ResourceWithModifyPlan     = &AponoManagedGroupResource{}
 )
 
+const (
+	memberCheckWarn  = "warn"
+	memberCheckError = "error"
+)
+
+// managedGroupSchemaVersion is the schema version of apono_managed_group.
+const managedGroupSchemaVersion = 1
+
 func NewAponoManagedGroupResource() resource.Resource {
 	return &AponoManagedGroupResource{}
 }
 
 // AponoManagedGroupResource manages Apono Group resources.
 type AponoManagedGroupResource struct {
-	client client.Invoker
+	client   client.Invoker
+	settings common.ProviderSettings
 }
 
 func (r *AponoManagedGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_managed_group"
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *AponoManagedGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     managedGroupSchemaVersion,
 		Description: "Manages an Apono Group exclusively - a collection of users for simplified access‑control and approval workflows.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -51,19 +72,230 @@ func (r *AponoManagedGroupResource) Schema(_ context.Context, _ resource.SchemaR
 				Required:    true,
 			},
 			"members": schema.SetAttribute{
-				Description: "List of user email addresses to include in the group. Must contain at least one member.",
+				MarkdownDescription: "List of user email addresses to include in the group. Must contain at least one member. " +
+					"Emails are compared ignoring case, and an email alias of an Apono user matches the user's primary email, so neither causes a diff. " +
+					"Exactly one of `members` or `member_rules` must be set; when `member_rules` is set, these are the primary emails of the users that match the rules.",
 				ElementType: types.StringType,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(common.EmailValidator()),
+					setvalidator.ExactlyOneOf(path.MatchRoot("member_rules")),
+				},
+			},
+			"member_rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Rules that define the members of the group instead of `members`. The group has the active Apono users that match all rules as members. " +
+					"The rules are evaluated against the current Apono users on every plan, so the plan shows the members that join or leave the group as user attributes change.",
+				Optional: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "User property to match. Possible values: `attribute`, a custom user attribute from the identity provider, such as `department`; `role`, an Apono role, such as `Admin`; `email`, the primary email or an email alias; `source_integration`, the ID or name of the integration the user is from.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(services.MemberRuleTypes...),
+							},
+						},
+						"attribute_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the custom user attribute to match, e.g. `department`. Required when `type` is `attribute`.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"match_operator": schema.StringAttribute{
+							MarkdownDescription: "Comparison operator. Possible values: `is`, `is_not`, `contains`, `does_not_contain`, `starts_with`. Defaults to `is`. " +
+								"Properties with several values, such as roles, match `is` when any of their values equals any of `values`, and `is_not` when none does.",
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString(common.DefaultMatchOperator),
+							Validators: []validator.String{
+								stringvalidator.OneOf(common.MatchOperators...),
+							},
+						},
+						"values": schema.ListAttribute{
+							Description: "Values to compare the user property with. Values are case sensitive, except for emails.",
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"member_check": schema.StringAttribute{
+				MarkdownDescription: "Checks at plan time, when the group is created or its members change, that each member is the primary email or an email alias of an active Apono user. " +
+					"Set to `warn` to report problems as warnings, or `error` to fail the plan. By default the members aren't checked.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(memberCheckWarn, memberCheckError),
+				},
 			},
 		},
 	}
 }
 
+func (r *AponoManagedGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.GetResourceIdentitySchema("group")
+}
+
 func (r *AponoManagedGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	common.ConfigureResourceClientInvoker(ctx, req, resp, &r.client)
+	common.ConfigureResourceSettings(ctx, req, resp, &r.settings)
+}
+
+// ValidateConfig rejects members that differ only by case, since Apono treats them as the same user, and member
+// rules on attributes without an attribute name.
+func (r *AponoManagedGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var rules types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("member_rules"), &rules)...)
+	if !resp.Diagnostics.HasError() && !rules.IsNull() && !rules.IsUnknown() {
+		var ruleModels []models.GroupMemberRuleModel
+		resp.Diagnostics.Append(rules.ElementsAs(ctx, &ruleModels, false)...)
+		for i, rule := range ruleModels {
+			if rule.Type.ValueString() == services.MemberRuleTypeAttribute && rule.AttributeName.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("member_rules").AtListIndex(i).AtName("attribute_name"),
+					"Missing Attribute Name",
+					`attribute_name must be set when type is "attribute".`,
+				)
+			}
+		}
+	}
+
+	var members types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("members"), &members)...)
+	if resp.Diagnostics.HasError() || members.IsNull() || members.IsUnknown() {
+		return
+	}
+
+	seen := map[string]string{}
+	for _, email := range knownMemberEmails(members) {
+		normalized := common.NormalizeEmail(email)
+		if other, ok := seen[normalized]; ok {
+			resp.Diagnostics.AddAttributeError(
+				memberPath(email),
+				"Duplicate Member",
+				fmt.Sprintf("%q and %q are the same email, emails are compared ignoring case.", other, email),
+			)
+			continue
+		}
+		seen[normalized] = email
+	}
+}
+
+// ModifyPlan evaluates member_rules to the planned members, and checks that the members are active Apono users when
+// member_check is set.
+func (r *AponoManagedGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var rules types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("member_rules"), &rules)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !rules.IsNull() {
+		r.planRuleMembers(ctx, rules, resp)
+		return
+	}
+
+	var members types.Set
+	var memberCheck types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("members"), &members)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("member_check"), &memberCheck)...)
+	if resp.Diagnostics.HasError() || memberCheck.IsNull() || memberCheck.IsUnknown() || members.IsNull() || members.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateMembers types.Set
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("members"), &stateMembers)...)
+		if resp.Diagnostics.HasError() || stateMembers.Equal(members) {
+			return
+		}
+	}
+
+	users, err := services.ListUsers(ctx, r.client)
+	if err != nil {
+		tflog.Warn(ctx, "Could not list users, skipping the member check", map[string]any{"error": err.Error()})
+		return
+	}
+
+	directory := services.NewUserDirectory(users)
+	emailsByUser := map[string]string{}
+	for _, email := range knownMemberEmails(members) {
+		if problem := directory.MemberProblem(email); problem != "" {
+			r.addMemberProblem(resp, email, memberCheck.ValueString(), problem)
+			continue
+		}
+
+		user, _ := directory.FindByEmail(email)
+		if other, ok := emailsByUser[user.ID]; ok {
+			r.addMemberProblem(resp, email, memberCheck.ValueString(), fmt.Sprintf("%q and %q are emails of the same Apono user, whose primary email is %q.", other, email, user.Email))
+			continue
+		}
+		emailsByUser[user.ID] = email
+	}
+}
+
+// planRuleMembers sets the planned members to the active users that match the member rules. The members are left
+// unknown while the rules are. They are active users by definition, so they aren't checked.
+func (r *AponoManagedGroupResource) planRuleMembers(ctx context.Context, rules types.List, resp *resource.ModifyPlanResponse) {
+	if rules.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("members"), types.SetUnknown(types.StringType))...)
+		return
+	}
+
+	var ruleModels []models.GroupMemberRuleModel
+	resp.Diagnostics.Append(rules.ElementsAs(ctx, &ruleModels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !services.MemberRulesKnown(ruleModels) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("members"), types.SetUnknown(types.StringType))...)
+		return
+	}
+
+	users, err := services.ListUsers(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("member_rules"), "Unable to Evaluate Member Rules", fmt.Sprintf("Could not list users: %v", err))
+		return
+	}
+
+	emails := services.MatchMemberRules(users, ruleModels)
+	if len(emails) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("member_rules"), "No Matching Members", "No active Apono user matches all member rules, and a group must have at least one member.")
+		return
+	}
+
+	members, diags := types.SetValueFrom(ctx, types.StringType, emails)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("members"), members)...)
+}
+
+func (r *AponoManagedGroupResource) addMemberProblem(resp *resource.ModifyPlanResponse, email string, check string, problem string) {
+	if check == memberCheckError {
+		resp.Diagnostics.AddAttributeError(memberPath(email), "Member Check Failed", problem+" Set member_check to \"warn\" to apply anyway.")
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(memberPath(email), "Member Check Failed", problem)
 }
 
 func (r *AponoManagedGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(r.settings.CheckWriteAllowed("create managed group")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var plan models.GroupModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -96,9 +328,12 @@ func (r *AponoManagedGroupResource) Create(ctx context.Context, req resource.Cre
 
 	result := models.GroupToModel(group)
 	result.Members = plan.Members
+	result.MemberRules = plan.MemberRules
+	result.MemberCheck = plan.MemberCheck
 
 	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, result.ID, result.Name)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -131,13 +366,27 @@ func (r *AponoManagedGroupResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	result := models.GroupToModel(group)
+	result.MemberRules = state.MemberRules
+	result.MemberCheck = state.MemberCheck
 
-	memberEmails := []string{}
+	members := make([]string, 0, len(membersResp))
 	for _, member := range membersResp {
-		memberEmails = append(memberEmails, member.Email)
+		members = append(members, member.Email)
+	}
+
+	prior := knownMemberEmails(state.Members)
+	canonicalEmail := common.NormalizeEmail
+	if mayUseEmailAliases(members, prior) {
+		// The API returns primary emails, so configured aliases can only be matched through the user directory
+		users, err := services.ListUsers(ctx, r.client)
+		if err != nil {
+			tflog.Warn(ctx, "Could not list users, email aliases of group members are not resolved", map[string]any{"error": err.Error()})
+		} else {
+			canonicalEmail = services.NewUserDirectory(users).PrimaryEmail
+		}
 	}
 
-	membersSet, diags := types.SetValueFrom(ctx, types.StringType, memberEmails)
+	membersSet, diags := models.ReconcileGroupMembers(ctx, members, prior, canonicalEmail)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -147,12 +396,18 @@ func (r *AponoManagedGroupResource) Read(ctx context.Context, req resource.ReadR
 
 	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, result.ID, result.Name)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
 func (r *AponoManagedGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(r.settings.CheckWriteAllowed("update managed group")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state models.GroupModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -214,8 +469,12 @@ func (r *AponoManagedGroupResource) Update(ctx context.Context, req resource.Upd
 		state.Members = plan.Members
 	}
 
+	state.MemberRules = plan.MemberRules
+	state.MemberCheck = plan.MemberCheck
+
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, state.ID, state.Name)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -224,6 +483,11 @@ func (r *AponoManagedGroupResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *AponoManagedGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.Append(r.settings.CheckWriteAllowed("delete managed group")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state models.GroupModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -243,6 +507,78 @@ func (r *AponoManagedGroupResource) Delete(ctx context.Context, req resource.Del
 	tflog.Info(ctx, "Deleted group successfully", map[string]any{"id": state.ID.ValueString()})
 }
 
+// ImportState supports importing by ID or by name, using the "name:<group name>" import ID format,
+// or by the id of the resource identity.
 func (r *AponoManagedGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, diags := common.GetImportID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := services.ResolveImportID(importID, "group", func(name string) ([]string, error) {
+		groups, err := services.ListGroups(ctx, r.client, name)
+		if err != nil {
+			return nil, err
+		}
+
+		var ids []string
+		for _, group := range groups {
+			if group.Name == name {
+				ids = append(ids, group.ID)
+			}
+		}
+
+		return ids, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing group", err.Error())
+		return
+	}
+
+	req.ID = id
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// knownMemberEmails returns the known emails of a members set, sorted so diagnostics are reported in a stable order.
+func knownMemberEmails(members types.Set) []string {
+	var emails []string
+	for _, element := range members.Elements() {
+		if email, ok := element.(types.String); ok && !email.IsNull() && !email.IsUnknown() {
+			emails = append(emails, email.ValueString())
+		}
+	}
+	sort.Strings(emails)
+
+	return emails
+}
+
+// mayUseEmailAliases reports whether some of the prior members and some of the members returned by the API don't
+// match ignoring case, which is the case when a prior member is an email alias of a member's user.
+func mayUseEmailAliases(members []string, prior []string) bool {
+	unmatched := func(emails []string, others []string) bool {
+		normalized := map[string]bool{}
+		for _, email := range others {
+			normalized[common.NormalizeEmail(email)] = true
+		}
+		for _, email := range emails {
+			if !normalized[common.NormalizeEmail(email)] {
+				return true
+			}
+		}
+		return false
+	}
+
+	return unmatched(members, prior) && unmatched(prior, members)
+}
+
+func memberPath(email string) path.Path {
+	return path.Root("members").AtSetValue(types.StringValue(email))
+}
+
+// UpgradeState upgrades state written by earlier schema versions.
+func (r *AponoManagedGroupResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: common.StateUpgraderFromV0(r),
+	}
+}
@@ -7,9 +7,13 @@ import (
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/mocks"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/testcommon"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -21,14 +25,9 @@ func TestAponoManagedGroupResource(t *testing.T) {
 	r := &AponoManagedGroupResource{client: mockInvoker}
 
 	getStateType := func() tftypes.Type {
-		return tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"id":      tftypes.String,
-				"name":    tftypes.String,
-				"members": tftypes.Set{ElementType: tftypes.String},
-			},
-		}
+		return r.getTestSchema(t.Context()).Type().TerraformType(t.Context())
 	}
+	memberRulesType := getStateType().(tftypes.Object).AttributeTypes["member_rules"]
 
 	getPlanType := func() tftypes.Type {
 		return getStateType()
@@ -37,8 +36,10 @@ func TestAponoManagedGroupResource(t *testing.T) {
 	t.Run("Create", func(t *testing.T) {
 		planType := getPlanType()
 		planVal := tftypes.NewValue(planType, map[string]tftypes.Value{
-			"id":   tftypes.NewValue(tftypes.String, nil),
-			"name": tftypes.NewValue(tftypes.String, "test-group"),
+			"id":           tftypes.NewValue(tftypes.String, nil),
+			"name":         tftypes.NewValue(tftypes.String, "test-group"),
+			"member_check": tftypes.NewValue(tftypes.String, nil),
+			"member_rules": tftypes.NewValue(memberRulesType, nil),
 			"members": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
 				tftypes.NewValue(tftypes.String, "user1@example.com"),
 				tftypes.NewValue(tftypes.String, "user2@example.com"),
@@ -98,9 +99,11 @@ func TestAponoManagedGroupResource(t *testing.T) {
 
 		stateType := getStateType()
 		stateVal := tftypes.NewValue(stateType, map[string]tftypes.Value{
-			"id":      tftypes.NewValue(tftypes.String, "group-123456"),
-			"name":    tftypes.NewValue(tftypes.String, "old-name"),
-			"members": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{}),
+			"id":           tftypes.NewValue(tftypes.String, "group-123456"),
+			"name":         tftypes.NewValue(tftypes.String, "old-name"),
+			"member_check": tftypes.NewValue(tftypes.String, nil),
+			"member_rules": tftypes.NewValue(memberRulesType, nil),
+			"members":      tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{}),
 		})
 
 		ctx := t.Context()
@@ -131,8 +134,10 @@ func TestAponoManagedGroupResource(t *testing.T) {
 	t.Run("Update", func(t *testing.T) {
 		planType := getPlanType()
 		planVal := tftypes.NewValue(planType, map[string]tftypes.Value{
-			"id":   tftypes.NewValue(tftypes.String, "group-123456"),
-			"name": tftypes.NewValue(tftypes.String, "updated-group"),
+			"id":           tftypes.NewValue(tftypes.String, "group-123456"),
+			"name":         tftypes.NewValue(tftypes.String, "updated-group"),
+			"member_check": tftypes.NewValue(tftypes.String, nil),
+			"member_rules": tftypes.NewValue(memberRulesType, nil),
 			"members": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
 				tftypes.NewValue(tftypes.String, "user3@example.com"),
 			}),
@@ -140,8 +145,10 @@ func TestAponoManagedGroupResource(t *testing.T) {
 
 		stateType := getStateType()
 		stateVal := tftypes.NewValue(stateType, map[string]tftypes.Value{
-			"id":   tftypes.NewValue(tftypes.String, "group-123456"),
-			"name": tftypes.NewValue(tftypes.String, "test-group"),
+			"id":           tftypes.NewValue(tftypes.String, "group-123456"),
+			"name":         tftypes.NewValue(tftypes.String, "test-group"),
+			"member_check": tftypes.NewValue(tftypes.String, nil),
+			"member_rules": tftypes.NewValue(memberRulesType, nil),
 			"members": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
 				tftypes.NewValue(tftypes.String, "user1@example.com"),
 				tftypes.NewValue(tftypes.String, "user2@example.com"),
@@ -188,9 +195,11 @@ func TestAponoManagedGroupResource(t *testing.T) {
 	t.Run("Delete", func(t *testing.T) {
 		stateType := getStateType()
 		stateVal := tftypes.NewValue(stateType, map[string]tftypes.Value{
-			"id":      tftypes.NewValue(tftypes.String, "group-123456"),
-			"name":    tftypes.NewValue(tftypes.String, "test-group"),
-			"members": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{}),
+			"id":           tftypes.NewValue(tftypes.String, "group-123456"),
+			"name":         tftypes.NewValue(tftypes.String, "test-group"),
+			"member_check": tftypes.NewValue(tftypes.String, nil),
+			"member_rules": tftypes.NewValue(memberRulesType, nil),
+			"members":      tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{}),
 		})
 
 		mockInvoker.EXPECT().
@@ -266,6 +275,204 @@ func TestAponoManagedGroupResource(t *testing.T) {
 		assert.Contains(t, members, "user1@example.com")
 		assert.Contains(t, members, "user2@example.com")
 	})
+
+	t.Run("ReadKeepsConfiguredMemberEmails", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r := &AponoManagedGroupResource{client: mockInvoker}
+		ctx := t.Context()
+
+		mockInvoker.EXPECT().GetGroupV1(mock.Anything, client.GetGroupV1Params{ID: "group-123456"}).
+			Return(&client.GroupV1{ID: "group-123456", Name: "test-group"}, nil).Once()
+		mockInvoker.EXPECT().ListGroupMembersV1(mock.Anything, mock.Anything).
+			Return(&client.PublicApiListResponseGroupMemberPublicV1Model{
+				Items: []client.GroupMemberV1{
+					{Email: "john@example.com"},
+					{Email: "jane@example.com"},
+					{Email: "new@example.com"},
+				},
+			}, nil).Once()
+		mockInvoker.EXPECT().ListUsersV3(mock.Anything, client.ListUsersV3Params{}).
+			Return(&client.PublicApiListResponseUserPublicV3Model{Items: []client.UserV3{
+				{ID: "user-1", Email: "john@example.com", Active: true},
+				{ID: "user-2", Email: "jane@example.com", EmailAliases: []string{"jane.doe@example.com"}, Active: true},
+			}}, nil).Once()
+
+		state := tfsdk.State{Schema: r.getTestSchema(ctx)}
+		diags := state.Set(ctx, models.GroupModel{
+			ID:          types.StringValue("group-123456"),
+			Name:        types.StringValue("test-group"),
+			Members:     testcommon.CreateTestStringSet(t, []string{"John@Example.com", "Jane.Doe@example.com", "removed@example.com"}),
+			MemberCheck: types.StringValue("error"),
+		})
+		require.False(t, diags.HasError(), "Error setting state: %s", diags.Errors())
+
+		resp := resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), "Read returned error: %s", resp.Diagnostics.Errors())
+
+		var stateModel models.GroupModel
+		diags = resp.State.Get(ctx, &stateModel)
+		require.False(t, diags.HasError())
+
+		var members []string
+		diags = stateModel.Members.ElementsAs(ctx, &members, false)
+		require.False(t, diags.HasError())
+		assert.ElementsMatch(t, []string{"John@Example.com", "Jane.Doe@example.com", "new@example.com"}, members)
+		assert.Equal(t, "error", stateModel.MemberCheck.ValueString())
+	})
+
+	t.Run("ValidateConfigDuplicateMember", func(t *testing.T) {
+		ctx := t.Context()
+
+		config := tfsdk.Config{Schema: r.getTestSchema(ctx)}
+		plan := tfsdk.Plan{Schema: config.Schema}
+		diags := plan.Set(ctx, models.GroupModel{
+			ID:          types.StringNull(),
+			Name:        types.StringValue("test-group"),
+			Members:     testcommon.CreateTestStringSet(t, []string{"john@example.com", "John@Example.com"}),
+			MemberCheck: types.StringNull(),
+		})
+		require.False(t, diags.HasError(), "Error setting config: %s", diags.Errors())
+		config.Raw = plan.Raw
+
+		resp := resource.ValidateConfigResponse{}
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+		require.Len(t, resp.Diagnostics.Errors(), 1)
+		assert.Equal(t, `members[Value("john@example.com")]`, resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath).Path().String())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), `"John@Example.com" and "john@example.com" are the same email`)
+	})
+
+	t.Run("ModifyPlanMemberCheck", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r := &AponoManagedGroupResource{client: mockInvoker}
+		ctx := t.Context()
+
+		mockInvoker.EXPECT().ListUsersV3(mock.Anything, client.ListUsersV3Params{}).
+			Return(&client.PublicApiListResponseUserPublicV3Model{Items: []client.UserV3{
+				{ID: "user-1", Email: "john@example.com", Active: true},
+				{ID: "user-2", Email: "jane@example.com", EmailAliases: []string{"jane.doe@example.com"}, Active: true},
+				{ID: "user-3", Email: "bob@example.com", Active: false},
+			}}, nil).Once()
+
+		plan := tfsdk.Plan{Schema: r.getTestSchema(ctx)}
+		diags := plan.Set(ctx, models.GroupModel{
+			ID:   types.StringUnknown(),
+			Name: types.StringValue("test-group"),
+			Members: testcommon.CreateTestStringSet(t, []string{
+				"john@example.com", "jon@example.com", "bob@example.com", "jane@example.com", "jane.doe@example.com",
+			}),
+			MemberCheck: types.StringValue("error"),
+		})
+		require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, &resp)
+
+		details := map[string]string{}
+		for _, d := range resp.Diagnostics.Errors() {
+			details[d.(diag.DiagnosticWithPath).Path().String()] = d.Detail()
+		}
+		require.Len(t, details, 3)
+		assert.Contains(t, details[`members[Value("jon@example.com")]`], `Did you mean "john@example.com"?`)
+		assert.Contains(t, details[`members[Value("bob@example.com")]`], "is not active")
+		assert.Contains(t, details[`members[Value("jane@example.com")]`], `"jane.doe@example.com" and "jane@example.com" are emails of the same Apono user`)
+
+		// Unchanged members are not checked again.
+		state := tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}
+		resp = resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, &resp)
+		assert.Empty(t, resp.Diagnostics)
+	})
+
+	t.Run("ModifyPlanMemberRules", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r := &AponoManagedGroupResource{client: mockInvoker}
+		ctx := t.Context()
+
+		platform := client.OptNilUserV3Attributes{Value: map[string]string{"department": "Platform"}, Set: true}
+		mockInvoker.EXPECT().ListUsersV3(mock.Anything, client.ListUsersV3Params{}).
+			Return(&client.PublicApiListResponseUserPublicV3Model{Items: []client.UserV3{
+				{ID: "user-1", Email: "alice@example.com", Active: true, Roles: []string{"User"}, Attributes: platform},
+				{ID: "user-2", Email: "bob@example.com", Active: true, Roles: []string{"Admin"}, Attributes: platform},
+				{ID: "user-3", Email: "carol@example.com", Active: true, Roles: []string{"User"}},
+			}}, nil).Twice()
+
+		rules := []models.GroupMemberRuleModel{
+			{
+				Type:          types.StringValue("attribute"),
+				AttributeName: types.StringValue("department"),
+				MatchOperator: types.StringValue("is"),
+				Values:        testcommon.CreateTestStringList(t, []string{"Platform"}),
+			},
+			{
+				Type:          types.StringValue("role"),
+				AttributeName: types.StringNull(),
+				MatchOperator: types.StringValue("is_not"),
+				Values:        testcommon.CreateTestStringList(t, []string{"Admin"}),
+			},
+		}
+
+		plan := tfsdk.Plan{Schema: r.getTestSchema(ctx)}
+		diags := plan.Set(ctx, models.GroupModel{
+			ID:          types.StringUnknown(),
+			Name:        types.StringValue("platform"),
+			Members:     types.SetUnknown(types.StringType),
+			MemberRules: rules,
+			MemberCheck: types.StringNull(),
+		})
+		require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), "ModifyPlan returned error: %s", resp.Diagnostics.Errors())
+
+		var members []string
+		diags = resp.Plan.GetAttribute(ctx, path.Root("members"), &members)
+		require.False(t, diags.HasError())
+		assert.Equal(t, []string{"alice@example.com"}, members)
+
+		// Rules that match no active user fail the plan.
+		rules[1].Values = testcommon.CreateTestStringList(t, []string{"User", "Admin"})
+		diags = plan.Set(ctx, models.GroupModel{
+			ID:          types.StringUnknown(),
+			Name:        types.StringValue("platform"),
+			Members:     types.SetUnknown(types.StringType),
+			MemberRules: rules,
+			MemberCheck: types.StringNull(),
+		})
+		require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+
+		resp = resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, &resp)
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "No Matching Members", resp.Diagnostics.Errors()[0].Summary())
+	})
+
+	t.Run("ValidateConfigMemberRuleWithoutAttributeName", func(t *testing.T) {
+		ctx := t.Context()
+
+		config := tfsdk.Config{Schema: r.getTestSchema(ctx)}
+		plan := tfsdk.Plan{Schema: config.Schema}
+		diags := plan.Set(ctx, models.GroupModel{
+			ID:      types.StringNull(),
+			Name:    types.StringValue("platform"),
+			Members: types.SetNull(types.StringType),
+			MemberRules: []models.GroupMemberRuleModel{{
+				Type:          types.StringValue("attribute"),
+				AttributeName: types.StringNull(),
+				MatchOperator: types.StringNull(),
+				Values:        testcommon.CreateTestStringList(t, []string{"Platform"}),
+			}},
+			MemberCheck: types.StringNull(),
+		})
+		require.False(t, diags.HasError(), "Error setting config: %s", diags.Errors())
+		config.Raw = plan.Raw
+
+		resp := resource.ValidateConfigResponse{}
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+		require.Len(t, resp.Diagnostics.Errors(), 1)
+		assert.Equal(t, "member_rules[0].attribute_name", resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath).Path().String())
+	})
 }
 
 func (r *AponoManagedGroupResource) getTestSchema(ctx context.Context) schema.Schema {
@@ -8,8 +8,12 @@ import (
 	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/schemas"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/services"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,24 +21,41 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
 	_ resource.ResourceWithConfigure        = &AponoResourceIntegrationResource{}
 	_ resource.ResourceWithImportState      = &AponoResourceIntegrationResource{}
+	_ resource.ResourceWithIdentity         = &AponoResourceIntegrationResource{}
 	_ resource.ResourceWithConfigValidators = &AponoResourceIntegrationResource{}
+	_ resource.ResourceWithMoveState        = &AponoResourceIntegrationResource{}
+	_ resource.ResourceWithUpgradeState     = &AponoResourceIntegrationResource{}
+	_ resource.ResourceWithModifyPlan       = &AponoResourceIntegrationResource{}
 )
 
+const (
+	connectorCheckWarn  = "warn"
+	connectorCheckError = "error"
+)
+
+// resourceIntegrationSchemaVersion is the schema version of the resource. Version 1 introduced schema versioning, and
+// version 2 changed integration_config from a map of strings to a dynamic value.
+const resourceIntegrationSchemaVersion = 2
+
 func NewAponoResourceIntegrationResource() resource.Resource {
 	return &AponoResourceIntegrationResource{}
 }
 
 type AponoResourceIntegrationResource struct {
-	client client.Invoker
+	client   client.Invoker
+	settings common.ProviderSettings
 }
 
 func (r *AponoResourceIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_resource_integration"
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *AponoResourceIntegrationResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
@@ -50,6 +71,7 @@ func (r *AponoResourceIntegrationResource) ConfigValidators(_ context.Context) [
 
 func (r *AponoResourceIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     resourceIntegrationSchemaVersion,
 		Description: "Manages a Resource Integration, allowing Apono to connect and manage external cloud resources.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -68,8 +90,27 @@ func (r *AponoResourceIntegrationResource) Schema(_ context.Context, _ resource.
 				Required:    true,
 			},
 			"connector_id": schema.StringAttribute{
-				Description: "ID of the Apono Connector used for the integration.",
-				Required:    true,
+				MarkdownDescription: "ID of the Apono Connector used for the integration. Exactly one of `connector_id` or `connector_name` must be set; when `connector_name` is set, this is the ID of the connector with that name.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("connector_name")),
+				},
+			},
+			"connector_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Apono Connector used for the integration, resolved to `connector_id` at plan time. The plan fails when no connector or more than one connector has that exact name.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"connector_check": schema.StringAttribute{
+				MarkdownDescription: "Checks the connector at plan time when the integration is created or its connector or type changes: whether the connector is disconnected, and whether a cloud integration, such as `aws-account`, uses a connector installed on a different cloud provider. " +
+					"Set to `warn` to report problems as warnings, or `error` to fail the plan. By default the connector isn't checked.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(connectorCheckWarn, connectorCheckError),
+				},
 			},
 			"connected_resource_types": schema.ListAttribute{
 				Description: "List of resource types for the integration to discover.",
@@ -79,10 +120,31 @@ func (r *AponoResourceIntegrationResource) Schema(_ context.Context, _ resource.
 					listvalidator.SizeAtLeast(1),
 				},
 			},
-			"integration_config": schema.MapAttribute{
-				MarkdownDescription: "Integration-specific configuration that accepts key-value pairs. Refer to the [Integration Configuration documentation](https://docs.apono.io/metadata-for-integration-config) for specific configuration values.",
+			"integration_config": schema.DynamicAttribute{
+				MarkdownDescription: "Integration-specific configuration as an object. Values keep their type, so lists and objects can be set natively instead of with `jsonencode`. " +
+					"Values read from Apono that are semantically equal JSON to the configured values, such as a `jsonencode` string of the same list, don't cause drift. " +
+					"Refer to the [Integration Configuration documentation](https://docs.apono.io/metadata-for-integration-config) for specific configuration values.",
+				Required: true,
+				Validators: []validator.Dynamic{
+					common.DynamicObjectValidator(),
+				},
+			},
+			"integration_config_wo": schema.MapAttribute{
+				MarkdownDescription: "Write-only integration-specific configuration, merged with `integration_config` when sent to Apono and never stored in the Terraform state. Use it for secrets of integrations without a `secret_store_config`. Requires Terraform 1.11 or later.",
 				ElementType:         types.StringType,
-				Required:            true,
+				Optional:            true,
+				WriteOnly:           true,
+				Sensitive:           true,
+				Validators: []validator.Map{
+					mapvalidator.AlsoRequires(path.MatchRoot("integration_config_wo_version")),
+				},
+			},
+			"integration_config_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `integration_config_wo`. Write-only values are not stored, so changes to them are not detected; change this value to send the updated `integration_config_wo` to Apono.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("integration_config_wo")),
+				},
 			},
 			"secret_store_config": schemas.GetSecretStoreConfigSchema(schemas.ResourceMode),
 			"custom_access_details": schema.StringAttribute{
@@ -95,19 +157,115 @@ func (r *AponoResourceIntegrationResource) Schema(_ context.Context, _ resource.
 	}
 }
 
+func (r *AponoResourceIntegrationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = common.GetResourceIdentitySchema("resource integration")
+}
+
 func (r *AponoResourceIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	common.ConfigureResourceClientInvoker(ctx, req, resp, &r.client)
+	common.ConfigureResourceSettings(ctx, req, resp, &r.settings)
+}
+
+// ModifyPlan resolves connector_name to connector_id and checks the connector when connector_check is set.
+func (r *AponoResourceIntegrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var connectorName, connectorID, connectorCheck, integrationType types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("connector_name"), &connectorName)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("connector_id"), &connectorID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("connector_check"), &connectorCheck)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &integrationType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectorPath := path.Root("connector_id")
+	var connector *client.ConnectorV3
+	if !connectorName.IsNull() {
+		connectorPath = path.Root("connector_name")
+		if connectorName.IsUnknown() {
+			return
+		}
+
+		connectors, err := services.ListConnectors(ctx, r.client)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(connectorPath, "Unable to Resolve Connector", fmt.Sprintf("Could not list connectors: %v", err))
+			return
+		}
+
+		connector, err = services.FindConnectorByName(connectors, connectorName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(connectorPath, "Connector Not Found", fmt.Sprintf("Could not resolve connector_name: %s", err))
+			return
+		}
+
+		connectorID = types.StringValue(connector.ID)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("connector_id"), connectorID)...)
+	}
+
+	if connectorCheck.IsNull() || connectorCheck.IsUnknown() || connectorID.IsUnknown() || integrationType.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateConnectorID, stateType types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("connector_id"), &stateConnectorID)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("type"), &stateType)...)
+		if resp.Diagnostics.HasError() || (stateConnectorID.Equal(connectorID) && stateType.Equal(integrationType)) {
+			return
+		}
+	}
+
+	if connector == nil {
+		var err error
+		connector, err = r.client.GetConnectorV3(ctx, client.GetConnectorV3Params{ID: connectorID.ValueString()})
+		if err != nil {
+			if !client.IsNotFoundError(err) {
+				tflog.Warn(ctx, "Could not read the connector, skipping the connector check", map[string]any{"connector_id": connectorID.ValueString(), "error": err.Error()})
+				return
+			}
+			r.addConnectorProblem(resp, connectorPath, connectorCheck.ValueString(), fmt.Sprintf("Connector %q was not found.", connectorID.ValueString()))
+			return
+		}
+	}
+
+	for _, problem := range services.ConnectorProblems(connector, integrationType.ValueString()) {
+		r.addConnectorProblem(resp, connectorPath, connectorCheck.ValueString(), problem)
+	}
+}
+
+func (r *AponoResourceIntegrationResource) addConnectorProblem(resp *resource.ModifyPlanResponse, connectorPath path.Path, check string, problem string) {
+	if check == connectorCheckError {
+		resp.Diagnostics.AddAttributeError(connectorPath, "Connector Check Failed", problem+" Set connector_check to \"warn\" to apply anyway.")
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(connectorPath, "Connector Check Failed", problem)
 }
 
 func (r *AponoResourceIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan models.ResourceIntegrationModel
+	resp.Diagnostics.Append(r.settings.CheckWriteAllowed("create resource integration")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan models.ResourceIntegrationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("integration_config_wo"), &plan.IntegrationConfigWO)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	createReq, err := models.ResourceIntegrationModelToCreateRequest(ctx, plan)
+	requestModel, err := plan.WithWriteOnlyIntegrationConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("integration_config_wo"), "Invalid integration configuration", err.Error())
+		return
+	}
+
+	createReq, err := models.ResourceIntegrationModelToCreateRequest(ctx, requestModel)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating resource integration request",
@@ -134,15 +292,17 @@ func (r *AponoResourceIntegrationResource) Create(ctx context.Context, req resou
 		return
 	}
 
-	diags = resp.State.Set(ctx, result)
+	resourceModel := models.NewResourceIntegrationResourceModel(result, &plan)
+	diags = resp.State.Set(ctx, resourceModel)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, resourceModel.ID, resourceModel.Name)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
 func (r *AponoResourceIntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state models.ResourceIntegrationModel
+	var state models.ResourceIntegrationResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -179,15 +339,22 @@ func (r *AponoResourceIntegrationResource) Read(ctx context.Context, req resourc
 		return
 	}
 
-	diags = resp.State.Set(ctx, result)
+	resourceModel := models.NewResourceIntegrationResourceModel(result, &state)
+	diags = resp.State.Set(ctx, resourceModel)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, resourceModel.ID, resourceModel.Name)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
 func (r *AponoResourceIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state, plan models.ResourceIntegrationModel
+	resp.Diagnostics.Append(r.settings.CheckWriteAllowed("update resource integration")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state, plan models.ResourceIntegrationResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -196,11 +363,18 @@ func (r *AponoResourceIntegrationResource) Update(ctx context.Context, req resou
 
 	diags = req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("integration_config_wo"), &plan.IntegrationConfigWO)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updateReq, err := models.ResourceIntegrationModelToUpdateRequest(ctx, plan)
+	requestModel, err := plan.WithWriteOnlyIntegrationConfig(ctx)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("integration_config_wo"), "Invalid integration configuration", err.Error())
+		return
+	}
+
+	updateReq, err := models.ResourceIntegrationModelToUpdateRequest(ctx, requestModel)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating resource integration update request",
@@ -227,15 +401,22 @@ func (r *AponoResourceIntegrationResource) Update(ctx context.Context, req resou
 		return
 	}
 
-	diags = resp.State.Set(ctx, result)
+	resourceModel := models.NewResourceIntegrationResourceModel(result, &plan)
+	diags = resp.State.Set(ctx, resourceModel)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(common.SetResourceIdentity(ctx, resp.Identity, resourceModel.ID, resourceModel.Name)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
 func (r *AponoResourceIntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state models.ResourceIntegrationModel
+	resp.Diagnostics.Append(r.settings.CheckWriteAllowed("delete resource integration")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.ResourceIntegrationResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -255,6 +436,100 @@ func (r *AponoResourceIntegrationResource) Delete(ctx context.Context, req resou
 	}
 }
 
+// ImportState supports importing by ID or by name, using the "name:<resource integration name>" import ID format,
+// or by the id of the resource identity.
 func (r *AponoResourceIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, diags := common.GetImportID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := services.ResolveImportID(importID, "resource integration", func(name string) ([]string, error) {
+		integrations, err := services.ListIntegrations(ctx, r.client, "", name, "", []string{common.ResourceCategory})
+		if err != nil {
+			return nil, err
+		}
+
+		var ids []string
+		for _, integration := range integrations {
+			if integration.Name == name {
+				ids = append(ids, integration.ID)
+			}
+		}
+
+		return ids, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing resource integration", err.Error())
+		return
+	}
+
+	req.ID = id
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// MoveState supports `moved` blocks from apono_integration. Both resources share the same ID,
+// so only the ID is taken from the v1 state and the state is rebuilt by reading the object from the API by that ID.
+func (r *AponoResourceIntegrationResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				id, ok := common.GetMovedResourceID(req, resp, "apono_integration")
+				if !ok {
+					return
+				}
+
+				integration, err := r.client.GetIntegrationsByIdV4(ctx, client.GetIntegrationsByIdV4Params{ID: id})
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Error moving resource integration",
+						fmt.Sprintf("Could not read resource integration ID %s: %v", id, err),
+					)
+					return
+				}
+
+				if integration.Category != common.ResourceCategory {
+					resp.Diagnostics.AddError(
+						"Invalid resource integration type",
+						fmt.Sprintf("Expected resource integration, got %s", integration.Category),
+					)
+					return
+				}
+
+				result, err := models.ResourceIntegrationToModel(ctx, integration)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Error converting resource integration",
+						fmt.Sprintf("Could not convert resource integration: %s", err),
+					)
+					return
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, models.NewResourceIntegrationResourceModel(result, nil))...)
+			},
+		},
+	}
+}
+
+// UpgradeState upgrades state written by earlier schema versions.
+func (r *AponoResourceIntegrationResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	upgrader := common.StateUpgraderFromPriorTypes(r, map[string]tftypes.Type{
+		"integration_config": tftypes.Map{ElementType: tftypes.String},
+	}, upgradeIntegrationConfig)
+
+	return map[int64]resource.StateUpgrader{
+		0: upgrader,
+		1: upgrader,
+	}
+}
+
+// upgradeIntegrationConfig keeps an integration_config map of strings as a map in the dynamic attribute, so a
+// configuration that passes a map, e.g. a map(string) variable, has no diff after the upgrade.
+func upgradeIntegrationConfig(_ string, value tftypes.Value) (tftypes.Value, error) {
+	if value.IsNull() || !value.IsKnown() {
+		return tftypes.NewValue(tftypes.DynamicPseudoType, nil), nil
+	}
+
+	return value, nil
+}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
@@ -9,10 +10,14 @@ import (
 	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/testcommon"
+	"github.com/go-faster/jx"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -31,7 +36,7 @@ func TestAponoResourceIntegrationResource(t *testing.T) {
 
 		ctx := t.Context()
 
-		model, err := models.ResourceIntegrationToModel(ctx, mockResponse)
+		model, err := getTestResourceIntegrationModel(ctx, mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model")
 
 		model.ID = types.StringNull()
@@ -48,6 +53,7 @@ func TestAponoResourceIntegrationResource(t *testing.T) {
 
 		diags := req.Plan.Set(ctx, model)
 		require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+		req.Config = tfsdk.Config{Schema: req.Plan.Schema, Raw: req.Plan.Raw}
 
 		resp := resource.CreateResponse{
 			State: tfsdk.State{
@@ -60,7 +66,7 @@ func TestAponoResourceIntegrationResource(t *testing.T) {
 
 		require.False(t, resp.Diagnostics.HasError(), "Create returned error: %s", resp.Diagnostics.Errors())
 
-		var state models.ResourceIntegrationModel
+		var state models.ResourceIntegrationResourceModel
 		diags = resp.State.Get(ctx, &state)
 		require.False(t, diags.HasError(), "Error getting state: %s", diags.Errors())
 
@@ -77,7 +83,7 @@ func TestAponoResourceIntegrationResource(t *testing.T) {
 		mockResponse.Category = common.ResourceCategory
 		ctx := t.Context()
 
-		model, err := models.ResourceIntegrationToModel(ctx, mockResponse)
+		model, err := getTestResourceIntegrationModel(ctx, mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model")
 
 		mockInvoker.EXPECT().
@@ -103,7 +109,7 @@ func TestAponoResourceIntegrationResource(t *testing.T) {
 
 		require.False(t, resp.Diagnostics.HasError(), "Read returned error: %s", resp.Diagnostics.Errors())
 
-		var got models.ResourceIntegrationModel
+		var got models.ResourceIntegrationResourceModel
 		diags = resp.State.Get(ctx, &got)
 		require.False(t, diags.HasError(), "Error getting state: %s", diags.Errors())
 
@@ -112,6 +118,145 @@ func TestAponoResourceIntegrationResource(t *testing.T) {
 		assert.Equal(t, model.Type.ValueString(), got.Type.ValueString())
 	})
 
+	t.Run("CreateWithWriteOnlyConfig", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r.client = mockInvoker
+
+		mockResponse := testcommon.GenerateResourceIntegrationResponse()
+		mockResponse.Category = common.ResourceCategory
+		mockResponse.IntegrationConfig["password"] = common.StringToJx("secret")
+
+		ctx := t.Context()
+
+		model, err := getTestResourceIntegrationModel(ctx, testcommon.GenerateResourceIntegrationResponse())
+		require.NoError(t, err, "Failed to convert mock response to model")
+
+		model.ID = types.StringNull()
+		model.IntegrationConfigWOVersion = types.Int64Value(1)
+
+		mockInvoker.EXPECT().
+			CreateIntegrationV4(mock.Anything, mock.MatchedBy(func(req *client.CreateIntegrationV4) bool {
+				password, err := common.JxToString(req.IntegrationConfig["password"])
+				return err == nil && password == "secret" && len(req.IntegrationConfig) == 5
+			})).
+			Return(mockResponse, nil)
+
+		req := resource.CreateRequest{
+			Plan: tfsdk.Plan{
+				Schema: r.getTestSchema(ctx),
+			},
+		}
+
+		diags := req.Plan.Set(ctx, model)
+		require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+
+		configModel := *model
+		configModel.IntegrationConfigWO = types.MapValueMust(types.StringType, map[string]attr.Value{"password": types.StringValue("secret")})
+		config := tfsdk.State{Schema: r.getTestSchema(ctx)}
+		diags = config.Set(ctx, configModel)
+		require.False(t, diags.HasError(), "Error setting config: %s", diags.Errors())
+		req.Config = tfsdk.Config{Schema: config.Schema, Raw: config.Raw}
+
+		resp := resource.CreateResponse{
+			State: tfsdk.State{
+				Schema: r.getTestSchema(ctx),
+				Raw:    req.Plan.Raw,
+			},
+		}
+
+		r.Create(ctx, req, &resp)
+
+		require.False(t, resp.Diagnostics.HasError(), "Create returned error: %s", resp.Diagnostics.Errors())
+
+		var state models.ResourceIntegrationResourceModel
+		diags = resp.State.Get(ctx, &state)
+		require.False(t, diags.HasError(), "Error getting state: %s", diags.Errors())
+
+		assert.Equal(t, model.IntegrationConfig, state.IntegrationConfig)
+		assert.NotContains(t, state.IntegrationConfig.UnderlyingValue().(types.Object).Attributes(), "password")
+		assert.True(t, state.IntegrationConfigWO.IsNull())
+		assert.Equal(t, int64(1), state.IntegrationConfigWOVersion.ValueInt64())
+	})
+
+	t.Run("CreateWithDuplicateWriteOnlyKey", func(t *testing.T) {
+		r.client = mocks.NewInvoker(t)
+		ctx := t.Context()
+
+		model, err := getTestResourceIntegrationModel(ctx, testcommon.GenerateResourceIntegrationResponse())
+		require.NoError(t, err, "Failed to convert mock response to model")
+		model.IntegrationConfigWOVersion = types.Int64Value(1)
+
+		req := resource.CreateRequest{
+			Plan: tfsdk.Plan{
+				Schema: r.getTestSchema(ctx),
+			},
+		}
+		diags := req.Plan.Set(ctx, model)
+		require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+
+		configModel := *model
+		configModel.IntegrationConfigWO = types.MapValueMust(types.StringType, map[string]attr.Value{"host": types.StringValue("other-host")})
+		config := tfsdk.State{Schema: r.getTestSchema(ctx)}
+		diags = config.Set(ctx, configModel)
+		require.False(t, diags.HasError(), "Error setting config: %s", diags.Errors())
+		req.Config = tfsdk.Config{Schema: config.Schema, Raw: config.Raw}
+
+		resp := resource.CreateResponse{
+			State: tfsdk.State{
+				Schema: r.getTestSchema(ctx),
+				Raw:    req.Plan.Raw,
+			},
+		}
+
+		r.Create(ctx, req, &resp)
+
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), `"host"`)
+	})
+
+	t.Run("ReadWithWriteOnlyConfig", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r.client = mockInvoker
+
+		mockResponse := testcommon.GenerateResourceIntegrationResponse()
+		mockResponse.Category = common.ResourceCategory
+		mockResponse.IntegrationConfig["password"] = common.StringToJx("secret")
+		ctx := t.Context()
+
+		model, err := getTestResourceIntegrationModel(ctx, testcommon.GenerateResourceIntegrationResponse())
+		require.NoError(t, err, "Failed to convert mock response to model")
+		model.IntegrationConfigWOVersion = types.Int64Value(2)
+
+		mockInvoker.EXPECT().
+			GetIntegrationsByIdV4(mock.Anything, client.GetIntegrationsByIdV4Params{ID: mockResponse.ID}).
+			Return(mockResponse, nil)
+
+		req := resource.ReadRequest{
+			State: tfsdk.State{
+				Schema: r.getTestSchema(ctx),
+			},
+		}
+		diags := req.State.Set(ctx, model)
+		require.False(t, diags.HasError(), "Error setting state: %s", diags.Errors())
+
+		resp := resource.ReadResponse{
+			State: tfsdk.State{
+				Schema: r.getTestSchema(ctx),
+				Raw:    req.State.Raw,
+			},
+		}
+
+		r.Read(ctx, req, &resp)
+
+		require.False(t, resp.Diagnostics.HasError(), "Read returned error: %s", resp.Diagnostics.Errors())
+
+		var got models.ResourceIntegrationResourceModel
+		diags = resp.State.Get(ctx, &got)
+		require.False(t, diags.HasError(), "Error getting state: %s", diags.Errors())
+
+		assert.Equal(t, *model, got)
+	})
+
 	t.Run("Read_NotFound", func(t *testing.T) {
 		mockInvoker := mocks.NewInvoker(t)
 		r.client = mockInvoker
@@ -120,7 +265,7 @@ func TestAponoResourceIntegrationResource(t *testing.T) {
 		notFoundErr := &client.NotFoundError{}
 
 		mockResponse := testcommon.GenerateResourceIntegrationResponse()
-		model, err := models.ResourceIntegrationToModel(ctx, mockResponse)
+		model, err := getTestResourceIntegrationModel(ctx, mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model")
 
 		mockInvoker.EXPECT().
@@ -163,10 +308,10 @@ func TestAponoResourceIntegrationResource(t *testing.T) {
 		updatedResponse.CustomAccessDetails.Value = "Updated access details"
 		updatedResponse.ConnectedResourceTypes.Value = append(updatedResponse.ConnectedResourceTypes.Value, "role")
 
-		stateModel, err := models.ResourceIntegrationToModel(ctx, mockResponse)
+		stateModel, err := getTestResourceIntegrationModel(ctx, mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model")
 
-		planModel, err := models.ResourceIntegrationToModel(ctx, updatedResponse)
+		planModel, err := getTestResourceIntegrationModel(ctx, updatedResponse)
 		require.NoError(t, err, "Failed to convert updated response to model")
 
 		mockInvoker.EXPECT().
@@ -184,6 +329,7 @@ func TestAponoResourceIntegrationResource(t *testing.T) {
 
 		diags := req.Plan.Set(ctx, planModel)
 		require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+		req.Config = tfsdk.Config{Schema: req.Plan.Schema, Raw: req.Plan.Raw}
 		diags = req.State.Set(ctx, stateModel)
 		require.False(t, diags.HasError(), "Error setting state: %s", diags.Errors())
 
@@ -198,7 +344,7 @@ func TestAponoResourceIntegrationResource(t *testing.T) {
 
 		require.False(t, resp.Diagnostics.HasError(), "Update returned error: %s", resp.Diagnostics.Errors())
 
-		var got models.ResourceIntegrationModel
+		var got models.ResourceIntegrationResourceModel
 		diags = resp.State.Get(ctx, &got)
 		require.False(t, diags.HasError(), "Error getting state: %s", diags.Errors())
 
@@ -222,7 +368,7 @@ func TestAponoResourceIntegrationResource(t *testing.T) {
 		mockResponse := testcommon.GenerateResourceIntegrationResponse()
 		mockResponse.Category = common.ResourceCategory
 
-		model, err := models.ResourceIntegrationToModel(ctx, mockResponse)
+		model, err := getTestResourceIntegrationModel(ctx, mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model")
 
 		mockInvoker.EXPECT().
@@ -253,7 +399,7 @@ func TestAponoResourceIntegrationResource(t *testing.T) {
 		notFoundErr := &client.NotFoundError{}
 
 		mockResponse := testcommon.GenerateResourceIntegrationResponse()
-		model, err := models.ResourceIntegrationToModel(ctx, mockResponse)
+		model, err := getTestResourceIntegrationModel(ctx, mockResponse)
 		require.NoError(t, err, "Failed to convert mock response to model")
 
 		mockInvoker.EXPECT().
@@ -308,7 +454,7 @@ func TestAponoResourceIntegrationResource(t *testing.T) {
 		readResp := resource.ReadResponse{State: resp.State}
 		r.Read(ctx, readReq, &readResp)
 
-		var imported models.ResourceIntegrationModel
+		var imported models.ResourceIntegrationResourceModel
 		diags := readResp.State.Get(ctx, &imported)
 		require.False(t, diags.HasError())
 
@@ -316,6 +462,183 @@ func TestAponoResourceIntegrationResource(t *testing.T) {
 		assert.Equal(t, mockResponse.Name, imported.Name.ValueString())
 		assert.Equal(t, mockResponse.Type, imported.Type.ValueString())
 	})
+
+	t.Run("UpgradeStateIntegrationConfigMap", func(t *testing.T) {
+		ctx := t.Context()
+
+		rawState := `{
+  "id": "integration-123",
+  "name": "ssh-integration",
+  "type": "ssh",
+  "connector_id": "connector-id-123",
+  "connected_resource_types": ["ssh-server"],
+  "integration_config": {"servers": "[{\"host\": \"10.0.8.15\"}]", "shell": "/bin/bash"},
+  "integration_config_wo_version": null
+}`
+
+		for _, version := range []int64{0, 1} {
+			req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(rawState)}}
+			resp := resource.UpgradeStateResponse{State: tfsdk.State{Schema: r.getTestSchema(ctx)}}
+
+			r.UpgradeState(ctx)[version].StateUpgrader(ctx, req, &resp)
+			require.False(t, resp.Diagnostics.HasError(), "UpgradeState returned error: %s", resp.Diagnostics.Errors())
+
+			var upgraded models.ResourceIntegrationResourceModel
+			diags := resp.State.Get(ctx, &upgraded)
+			require.False(t, diags.HasError(), "Error getting state: %s", diags.Errors())
+
+			assert.Equal(t, "integration-123", upgraded.ID.ValueString())
+			assert.Equal(t, types.DynamicValue(types.MapValueMust(
+				types.StringType,
+				map[string]attr.Value{"servers": types.StringValue(`[{"host": "10.0.8.15"}]`), "shell": types.StringValue("/bin/bash")},
+			)), upgraded.IntegrationConfig)
+		}
+	})
+
+	t.Run("CreateWithNativeConfigValues", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r.client = mockInvoker
+		ctx := t.Context()
+
+		mockResponse := testcommon.GenerateResourceIntegrationResponse()
+		mockResponse.IntegrationConfig["servers"] = jx.Raw(`[{"host":"10.0.8.15","port":2222}]`)
+
+		model, err := getTestResourceIntegrationModel(ctx, testcommon.GenerateResourceIntegrationResponse())
+		require.NoError(t, err, "Failed to convert mock response to model")
+		model.ID = types.StringNull()
+
+		server := types.ObjectValueMust(
+			map[string]attr.Type{"host": types.StringType, "port": types.NumberType},
+			map[string]attr.Value{"host": types.StringValue("10.0.8.15"), "port": types.NumberValue(big.NewFloat(2222))},
+		)
+		attributeTypes := map[string]attr.Type{"servers": types.TupleType{ElemTypes: []attr.Type{server.Type(ctx)}}}
+		attributes := map[string]attr.Value{"servers": types.TupleValueMust([]attr.Type{server.Type(ctx)}, []attr.Value{server})}
+		for key, value := range model.IntegrationConfig.UnderlyingValue().(types.Object).Attributes() {
+			attributeTypes[key] = value.Type(ctx)
+			attributes[key] = value
+		}
+		model.IntegrationConfig = types.DynamicValue(types.ObjectValueMust(attributeTypes, attributes))
+
+		mockInvoker.EXPECT().
+			CreateIntegrationV4(mock.Anything, mock.MatchedBy(func(req *client.CreateIntegrationV4) bool {
+				return string(req.IntegrationConfig["servers"]) == `[{"host":"10.0.8.15","port":2222}]`
+			})).
+			Return(mockResponse, nil)
+
+		req := resource.CreateRequest{
+			Plan:   tfsdk.Plan{Schema: r.getTestSchema(ctx)},
+			Config: tfsdk.Config{Schema: r.getTestSchema(ctx)},
+		}
+		diags := req.Plan.Set(ctx, model)
+		require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+		req.Config.Raw = req.Plan.Raw
+
+		resp := resource.CreateResponse{
+			State: tfsdk.State{
+				Schema: r.getTestSchema(ctx),
+				Raw:    req.Plan.Raw,
+			},
+		}
+
+		r.Create(ctx, req, &resp)
+		require.False(t, resp.Diagnostics.HasError(), "Create returned error: %s", resp.Diagnostics.Errors())
+
+		var state models.ResourceIntegrationResourceModel
+		diags = resp.State.Get(ctx, &state)
+		require.False(t, diags.HasError(), "Error getting state: %s", diags.Errors())
+
+		assert.True(t, model.IntegrationConfig.Equal(state.IntegrationConfig), "state integration_config %s differs from the plan", state.IntegrationConfig)
+	})
+
+	t.Run("ModifyPlanResolvesConnectorName", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r.client = mockInvoker
+		ctx := t.Context()
+
+		model, err := getTestResourceIntegrationModel(ctx, testcommon.GenerateResourceIntegrationResponse())
+		require.NoError(t, err, "Failed to convert mock response to model")
+		model.ConnectorName = types.StringValue("prod-eks")
+		model.ConnectorID = types.StringUnknown()
+
+		mockInvoker.EXPECT().ListConnectorsV3(mock.Anything, client.ListConnectorsV3Params{}).
+			Return(&client.PublicApiListResponseConnectorPublicV3Model{Items: []client.ConnectorV3{
+				{ID: "connector-1", Name: "prod-eks", Status: "CONNECTED"},
+				{ID: "connector-2", Name: "prod-gke", Status: "CONNECTED"},
+			}}, nil).Once()
+
+		plan := tfsdk.Plan{Schema: r.getTestSchema(ctx)}
+		diags := plan.Set(ctx, model)
+		require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, &resp)
+		require.False(t, resp.Diagnostics.HasError(), "ModifyPlan returned error: %s", resp.Diagnostics.Errors())
+
+		var connectorID types.String
+		diags = resp.Plan.GetAttribute(ctx, path.Root("connector_id"), &connectorID)
+		require.False(t, diags.HasError())
+		assert.Equal(t, "connector-1", connectorID.ValueString())
+	})
+
+	t.Run("ModifyPlanUnknownConnectorName", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r.client = mockInvoker
+		ctx := t.Context()
+
+		model, err := getTestResourceIntegrationModel(ctx, testcommon.GenerateResourceIntegrationResponse())
+		require.NoError(t, err, "Failed to convert mock response to model")
+		model.ConnectorName = types.StringValue("prod-eksx")
+		model.ConnectorID = types.StringUnknown()
+
+		mockInvoker.EXPECT().ListConnectorsV3(mock.Anything, client.ListConnectorsV3Params{}).
+			Return(&client.PublicApiListResponseConnectorPublicV3Model{Items: []client.ConnectorV3{{ID: "connector-1", Name: "prod-eks"}}}, nil).Once()
+
+		plan := tfsdk.Plan{Schema: r.getTestSchema(ctx)}
+		diags := plan.Set(ctx, model)
+		require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, &resp)
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, "Connector Not Found", resp.Diagnostics.Errors()[0].Summary())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), `Did you mean "prod-eks"?`)
+	})
+
+	t.Run("ModifyPlanConnectorCheck", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		r.client = mockInvoker
+		ctx := t.Context()
+
+		model, err := getTestResourceIntegrationModel(ctx, testcommon.GenerateResourceIntegrationResponse())
+		require.NoError(t, err, "Failed to convert mock response to model")
+
+		mockInvoker.EXPECT().GetConnectorV3(mock.Anything, client.GetConnectorV3Params{ID: model.ConnectorID.ValueString()}).
+			Return(&client.ConnectorV3{ID: model.ConnectorID.ValueString(), Name: "prod-eks", Status: "DISCONNECTED"}, nil).Twice()
+
+		for check, hasError := range map[string]bool{"error": true, "warn": false} {
+			model.ConnectorCheck = types.StringValue(check)
+
+			plan := tfsdk.Plan{Schema: r.getTestSchema(ctx)}
+			diags := plan.Set(ctx, model)
+			require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, &resp)
+			require.Len(t, resp.Diagnostics, 1, check)
+			assert.Equal(t, hasError, resp.Diagnostics.HasError(), check)
+			assert.Contains(t, resp.Diagnostics[0].Detail(), `Connector "prod-eks" is disconnected, it has never connected.`)
+		}
+
+		// An unchanged connector is not checked again.
+		plan := tfsdk.Plan{Schema: r.getTestSchema(ctx)}
+		diags := plan.Set(ctx, model)
+		require.False(t, diags.HasError(), "Error setting plan: %s", diags.Errors())
+
+		state := tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, &resp)
+		assert.Empty(t, resp.Diagnostics)
+	})
 }
 
 func (r *AponoResourceIntegrationResource) getTestSchema(ctx context.Context) schema.Schema {
@@ -323,3 +646,11 @@ func (r *AponoResourceIntegrationResource) getTestSchema(ctx context.Context) sc
 	r.Schema(ctx, resource.SchemaRequest{}, &resp)
 	return resp.Schema
 }
+
+func getTestResourceIntegrationModel(ctx context.Context, integration *client.IntegrationV4) (*models.ResourceIntegrationResourceModel, error) {
+	model, err := models.ResourceIntegrationToModel(ctx, integration)
+	if err != nil {
+		return nil, err
+	}
+	return models.NewResourceIntegrationResourceModel(model, nil), nil
+}
@@ -0,0 +1,101 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/mocks"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/testcommon"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMoveState checks that each v2 resource rebuilds its state from the API when moved from its v1 resource. The
+// handling of the source state itself is tested with common.GetMovedResourceID.
+func TestMoveState(t *testing.T) {
+	tests := []struct {
+		sourceTypeName string
+		// setup returns the resource, with its client expecting the read of the moved object, and the expected model.
+		setup func(t *testing.T, mockInvoker *mocks.Invoker) (resource.ResourceWithMoveState, any)
+	}{
+		{
+			sourceTypeName: "apono_access_flow",
+			setup: func(t *testing.T, mockInvoker *mocks.Invoker) (resource.ResourceWithMoveState, any) {
+				response := testcommon.GenerateAccessFlowResponse()
+				mockInvoker.EXPECT().GetAccessFlowV2(mock.Anything, mock.Anything).Return(response, nil).Once()
+
+				model, err := getTestAccessFlowModel(t.Context(), *response)
+				require.NoError(t, err)
+				return &AponoAccessFlowV2Resource{client: mockInvoker}, model
+			},
+		},
+		{
+			sourceTypeName: "apono_access_bundle",
+			setup: func(t *testing.T, mockInvoker *mocks.Invoker) (resource.ResourceWithMoveState, any) {
+				response := testcommon.GenerateBundleResponse()
+				mockInvoker.EXPECT().GetBundleV2(mock.Anything, mock.Anything).Return(response, nil).Once()
+
+				model, err := getTestBundleModel(t.Context(), *response)
+				require.NoError(t, err)
+				return &AponoBundleV2Resource{client: mockInvoker}, model
+			},
+		},
+		{
+			sourceTypeName: "apono_integration",
+			setup: func(t *testing.T, mockInvoker *mocks.Invoker) (resource.ResourceWithMoveState, any) {
+				response := testcommon.GenerateResourceIntegrationResponse()
+				response.Category = common.ResourceCategory
+				mockInvoker.EXPECT().GetIntegrationsByIdV4(mock.Anything, mock.Anything).Return(response, nil).Once()
+
+				model, err := getTestResourceIntegrationModel(t.Context(), response)
+				require.NoError(t, err)
+				return &AponoResourceIntegrationResource{client: mockInvoker}, model
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sourceTypeName, func(t *testing.T) {
+			ctx := t.Context()
+			r, model := tt.setup(t, mocks.NewInvoker(t))
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+			expected := tfsdk.State{Schema: schemaResp.Schema}
+			diags := expected.Set(ctx, model)
+			require.False(t, diags.HasError(), "Error setting expected state: %s", diags.Errors())
+
+			movers := r.MoveState(ctx)
+			require.Len(t, movers, 1)
+
+			req := resource.MoveStateRequest{
+				SourceProviderAddress: "registry.terraform.io/apono-io/apono",
+				SourceTypeName:        tt.sourceTypeName,
+				SourceRawState:        &tfprotov6.RawState{JSON: []byte(`{"id":"moved-id"}`)},
+			}
+			resp := resource.MoveStateResponse{
+				TargetState: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+
+			movers[0].StateMover(ctx, req, &resp)
+			require.False(t, resp.Diagnostics.HasError(), "MoveState returned error: %s", resp.Diagnostics.Errors())
+			assert.True(t, expected.Raw.Equal(resp.TargetState.Raw), "moved state differs from the API object:\n%s\n%s", expected.Raw, resp.TargetState.Raw)
+
+			// Moves from other resource types aren't handled.
+			req.SourceTypeName = "null_resource"
+			resp.TargetState.Raw = tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+			movers[0].StateMover(ctx, req, &resp)
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.True(t, resp.TargetState.Raw.IsNull())
+		})
+	}
+}
@@ -1,10 +1,44 @@
 package schemas
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// getReferenceAttributes returns the name and ID attributes referencing another object from an access target.
+// Resources set exactly one of them; referencing the ID keeps the access target valid when the object is renamed.
+func getReferenceAttributes(mode SchemaMode, nameAttribute, idAttribute, object string) map[string]schema.Attribute {
+	if mode == DataSourceMode {
+		return map[string]schema.Attribute{
+			nameAttribute: schema.StringAttribute{
+				Description: "The name of the " + object + ".",
+				Computed:    true,
+			},
+			idAttribute: schema.StringAttribute{
+				Description: "The ID of the " + object + ".",
+				Computed:    true,
+			},
+		}
+	}
+
+	return map[string]schema.Attribute{
+		nameAttribute: schema.StringAttribute{
+			Description: "The name of the " + object + ". Exactly one of " + nameAttribute + " or " + idAttribute + " must be set.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName(idAttribute)),
+			},
+		},
+		idAttribute: schema.StringAttribute{
+			Description: "The ID of the " + object + ". Unlike the name, the ID doesn't change when the " + object + " is renamed.",
+			Optional:    true,
+		},
+	}
+}
+
 func GetIntegrationTargetSchema(mode SchemaMode) schema.SingleNestedAttribute {
 	isComputed := mode == DataSourceMode
 	fieldsRequired := mode == ResourceMode
@@ -14,12 +48,7 @@ func GetIntegrationTargetSchema(mode SchemaMode) schema.SingleNestedAttribute {
 		Description: "Defines an integration and resources to which access will be granted.",
 		Optional:    !isComputed,
 		Computed:    isComputed,
-		Attributes: map[string]schema.Attribute{
-			"integration_name": schema.StringAttribute{
-				Description: "The name of the integration",
-				Required:    fieldsRequired,
-				Computed:    fieldsComputed,
-			},
+		Attributes: withReferenceAttributes(getReferenceAttributes(mode, "integration_name", "integration_id", "integration"), map[string]schema.Attribute{
 			"resource_type": schema.StringAttribute{
 				Description: "The type of resource within the integration for which access is being granted (e.g., aws-account-s3-bucket).",
 				Required:    fieldsRequired,
@@ -61,25 +90,35 @@ func GetIntegrationTargetSchema(mode SchemaMode) schema.SingleNestedAttribute {
 					},
 				},
 			},
-		},
+		}),
 	}
 }
 
 func GetAccessScopeTargetSchema(mode SchemaMode) schema.SingleNestedAttribute {
 	isComputed := mode == DataSourceMode
-	fieldsRequired := mode == ResourceMode
-	fieldsComputed := mode == DataSourceMode
 
 	return schema.SingleNestedAttribute{
 		Description: "Access scope target.",
 		Optional:    !isComputed,
 		Computed:    isComputed,
-		Attributes: map[string]schema.Attribute{
-			"name": schema.StringAttribute{
-				Description: "Name of the access scope.",
-				Required:    fieldsRequired,
-				Computed:    fieldsComputed,
-			},
-		},
+		Attributes:  getReferenceAttributes(mode, "name", "access_scope_id", "access scope"),
+	}
+}
+
+func GetBundleTargetSchema(mode SchemaMode) schema.SingleNestedAttribute {
+	isComputed := mode == DataSourceMode
+
+	return schema.SingleNestedAttribute{
+		Description: "Bundle target.",
+		Optional:    !isComputed,
+		Computed:    isComputed,
+		Attributes:  getReferenceAttributes(mode, "name", "bundle_id", "bundle"),
+	}
+}
+
+func withReferenceAttributes(referenceAttributes, attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	for name, attribute := range referenceAttributes {
+		attributes[name] = attribute
 	}
+	return attributes
 }
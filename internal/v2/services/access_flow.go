@@ -0,0 +1,42 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+)
+
+// ListAccessFlows retrieves all access flows. The API doesn't support filtering access flows by name.
+func ListAccessFlows(ctx context.Context, apiClient client.Invoker) ([]client.AccessFlowV2, error) {
+	results := []client.AccessFlowV2{}
+	pageToken := ""
+
+	for {
+		params := client.ListAccessFlowsV2Params{}
+		if pageToken != "" {
+			params.PageToken.SetTo(pageToken)
+		}
+
+		resp, err := apiClient.ListAccessFlowsV2(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list access flows: %w", err)
+		}
+
+		results = append(results, resp.Items...)
+
+		if resp.Pagination.NextPageToken.Value == "" {
+			break
+		}
+
+		pageToken = resp.Pagination.NextPageToken.Value
+	}
+
+	// Sort results by id for consistency
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].ID < results[j].ID
+	})
+
+	return results, nil
+}
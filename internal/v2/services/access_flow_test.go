@@ -0,0 +1,43 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAccessFlows(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("multiple pages", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+
+		nextToken := client.NewOptNilString("next")
+		mockInvoker.On("ListAccessFlowsV2", ctx, client.ListAccessFlowsV2Params{}).Return(&client.PublicApiListResponseAccessFlowPublicV2Model{
+			Items:      []client.AccessFlowV2{{ID: "af3"}},
+			Pagination: client.PublicApiPaginationInfoModel{NextPageToken: nextToken},
+		}, nil)
+
+		mockInvoker.On("ListAccessFlowsV2", ctx, client.ListAccessFlowsV2Params{PageToken: nextToken}).Return(&client.PublicApiListResponseAccessFlowPublicV2Model{
+			Items:      []client.AccessFlowV2{{ID: "af2"}, {ID: "af1"}},
+			Pagination: client.PublicApiPaginationInfoModel{NextPageToken: client.NewOptNilString("")},
+		}, nil)
+
+		result, err := ListAccessFlows(ctx, mockInvoker)
+		require.NoError(t, err)
+		assert.Equal(t, []client.AccessFlowV2{{ID: "af1"}, {ID: "af2"}, {ID: "af3"}}, result)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mockInvoker := mocks.NewInvoker(t)
+		mockInvoker.On("ListAccessFlowsV2", ctx, client.ListAccessFlowsV2Params{}).Return(nil, errors.New("api error"))
+
+		_, err := ListAccessFlows(ctx, mockInvoker)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to list access flows")
+	})
+}
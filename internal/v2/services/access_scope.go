@@ -2,10 +2,16 @@ package services
 
 import (
 	"context"
+	"fmt"
 	"sort"
 
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/aql"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 type AccessScopeModel struct {
@@ -15,6 +21,43 @@ type AccessScopeModel struct {
 	Query       types.String `tfsdk:"query"`
 }
 
+// AccessScopeResourceModel is the state of the apono_access_scope resource, which also previews the integrations
+// matched by the query.
+type AccessScopeResourceModel struct {
+	AccessScopeModel
+	Filter              *AccessScopeFilterModel `tfsdk:"filter"`
+	MatchedIntegrations types.Set               `tfsdk:"matched_integrations"`
+}
+
+// AccessScopeFilterModel is a structured alternative to the query of an access scope, compiled into AQL.
+type AccessScopeFilterModel struct {
+	AccessScopeFilterGroupModel
+	Groups []AccessScopeFilterGroupModel `tfsdk:"groups"`
+}
+
+// AccessScopeFilterGroupModel holds the conditions of an access scope filter or of one of its groups.
+type AccessScopeFilterGroupModel struct {
+	Operator             types.String          `tfsdk:"operator"`
+	Integrations         types.Set             `tfsdk:"integrations"`
+	ResourceTypes        types.Set             `tfsdk:"resource_types"`
+	Tags                 []AccessScopeTagModel `tfsdk:"tags"`
+	Permissions          types.Set             `tfsdk:"permissions"`
+	ResourceNameContains types.Set             `tfsdk:"resource_name_contains"`
+}
+
+type AccessScopeTagModel struct {
+	Key    types.String `tfsdk:"key"`
+	Values types.Set    `tfsdk:"values"`
+}
+
+// AccessScopeToResourceModel converts an access scope to the resource state, without the matched integrations.
+func AccessScopeToResourceModel(accessScope *client.AccessScopeV1) *AccessScopeResourceModel {
+	return &AccessScopeResourceModel{
+		AccessScopeModel:    *AccessScopeToModel(accessScope),
+		MatchedIntegrations: types.SetNull(types.StringType),
+	}
+}
+
 func AccessScopeToModel(accessScope *client.AccessScopeV1) *AccessScopeModel {
 	model := &AccessScopeModel{
 		ID:    types.StringValue(accessScope.ID),
@@ -71,3 +114,103 @@ func ListAccessScopesByName(ctx context.Context, apiClient client.Invoker, name
 
 	return results, nil
 }
+
+// MatchAccessScopeIntegrations returns the names of the resource integrations whose resources the query can match,
+// evaluated from the integration, integration_type and resource_type comparisons of the query.
+func MatchAccessScopeIntegrations(ctx context.Context, apiClient client.Invoker, query string) ([]string, error) {
+	expr, err := aql.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+
+	integrations, err := ListIntegrations(ctx, apiClient, "", "", "", []string{common.ResourceCategory})
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, integration := range integrations {
+		resourceTypes, _ := integration.ConnectedResourceTypes.Get()
+		match := aql.MatchIntegration(expr, aql.Integration{
+			ID:            integration.ID,
+			Name:          integration.Name,
+			Type:          integration.Type,
+			ResourceTypes: resourceTypes,
+		})
+		if match != aql.NoMatch {
+			names = append(names, integration.Name)
+		}
+	}
+
+	sort.Strings(names)
+	return names, nil
+}
+
+// AccessScopeFilterQuery compiles the filter attribute of an access scope into an AQL query. The query is unknown
+// while the filter has unknown values.
+func AccessScopeFilterQuery(ctx context.Context, filterPath path.Path, filter types.Object) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	value, err := filter.ToTerraformValue(ctx)
+	if err != nil {
+		diags.AddAttributeError(filterPath, "Invalid access scope filter", fmt.Sprintf("The filter can't be read: %s.", err))
+		return types.StringUnknown(), diags
+	}
+	if !value.IsFullyKnown() {
+		return types.StringUnknown(), diags
+	}
+
+	var model AccessScopeFilterModel
+	diags.Append(filter.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return types.StringUnknown(), diags
+	}
+
+	aqlFilter := model.AccessScopeFilterGroupModel.toAQL(ctx, &diags)
+	for _, group := range model.Groups {
+		aqlFilter.Groups = append(aqlFilter.Groups, group.toAQL(ctx, &diags))
+	}
+	if diags.HasError() {
+		return types.StringUnknown(), diags
+	}
+
+	query, err := aqlFilter.Compile()
+	if err != nil {
+		diags.AddAttributeError(filterPath, "Invalid access scope filter", fmt.Sprintf("The filter can't be compiled into a query: %s.", err))
+		return types.StringUnknown(), diags
+	}
+
+	return types.StringValue(query), diags
+}
+
+func (m AccessScopeFilterGroupModel) toAQL(ctx context.Context, diags *diag.Diagnostics) aql.Filter {
+	filter := aql.Filter{Operator: m.Operator.ValueString()}
+
+	for _, condition := range []struct {
+		set    types.Set
+		target *[]string
+	}{
+		{m.Integrations, &filter.Integrations},
+		{m.ResourceTypes, &filter.ResourceTypes},
+		{m.Permissions, &filter.Permissions},
+		{m.ResourceNameContains, &filter.ResourceNameContains},
+	} {
+		*condition.target = knownStrings(ctx, condition.set, diags)
+	}
+
+	for _, tag := range m.Tags {
+		filter.Tags = append(filter.Tags, aql.Tag{Key: tag.Key.ValueString(), Values: knownStrings(ctx, tag.Values, diags)})
+	}
+
+	return filter
+}
+
+func knownStrings(ctx context.Context, set types.Set, diags *diag.Diagnostics) []string {
+	if set.IsNull() {
+		return nil
+	}
+
+	var values []string
+	diags.Append(set.ElementsAs(ctx, &values, false)...)
+	return values
+}
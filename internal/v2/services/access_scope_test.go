@@ -5,7 +5,12 @@ import (
 
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestListAccessScopesByName(t *testing.T) {
@@ -139,3 +144,100 @@ func TestListAccessScopesByName(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchAccessScopeIntegrations(t *testing.T) {
+	ctx := t.Context()
+	mockClient := mocks.NewInvoker(t)
+
+	postgres := client.IntegrationV4{ID: "integration-1", Name: "postgres-prod", Type: "postgresql"}
+	postgres.ConnectedResourceTypes.SetTo([]string{"postgresql-database"})
+	mysql := client.IntegrationV4{ID: "integration-2", Name: "mysql-prod", Type: "mysql"}
+	mysql.ConnectedResourceTypes.SetTo([]string{"mysql-database"})
+	unsynced := client.IntegrationV4{ID: "integration-3", Name: "aws-dev", Type: "aws-account"}
+
+	params := client.ListIntegrationsV4Params{}
+	params.Category.SetTo([]string{"RESOURCES"})
+	mockClient.On("ListIntegrationsV4", ctx, params).Return(&client.PublicApiListResponseIntegrationPublicV4Model{
+		Items: []client.IntegrationV4{postgres, mysql, unsynced},
+	}, nil)
+
+	names, err := MatchAccessScopeIntegrations(ctx, mockClient, `resource_type = "postgresql-database" and resource_tag["env"] = "prod"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"aws-dev", "postgres-prod"}, names)
+
+	_, err = MatchAccessScopeIntegrations(ctx, mockClient, `resource_type =`)
+	assert.Error(t, err)
+}
+
+func TestAccessScopeFilterQuery(t *testing.T) {
+	ctx := t.Context()
+
+	stringSet := types.SetType{ElemType: types.StringType}
+	tagType := types.ObjectType{AttrTypes: map[string]attr.Type{"key": types.StringType, "values": stringSet}}
+	groupAttrTypes := map[string]attr.Type{
+		"operator":               types.StringType,
+		"integrations":           stringSet,
+		"resource_types":         stringSet,
+		"tags":                   types.ListType{ElemType: tagType},
+		"permissions":            stringSet,
+		"resource_name_contains": stringSet,
+	}
+	filterAttrTypes := map[string]attr.Type{"groups": types.ListType{ElemType: types.ObjectType{AttrTypes: groupAttrTypes}}}
+	for name, attrType := range groupAttrTypes {
+		filterAttrTypes[name] = attrType
+	}
+
+	set := func(values ...string) types.Set {
+		elements := make([]attr.Value, 0, len(values))
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+		return types.SetValueMust(types.StringType, elements)
+	}
+	group := func() AccessScopeFilterGroupModel {
+		return AccessScopeFilterGroupModel{
+			Operator:             types.StringNull(),
+			Integrations:         types.SetNull(types.StringType),
+			ResourceTypes:        types.SetNull(types.StringType),
+			Permissions:          types.SetNull(types.StringType),
+			ResourceNameContains: types.SetNull(types.StringType),
+		}
+	}
+	object := func(model AccessScopeFilterModel) types.Object {
+		value, diags := types.ObjectValueFrom(ctx, filterAttrTypes, model)
+		require.False(t, diags.HasError(), "Error building filter: %s", diags.Errors())
+		return value
+	}
+
+	filter := AccessScopeFilterModel{AccessScopeFilterGroupModel: group()}
+	filter.Integrations = set("postgresql-prod")
+	filter.Tags = []AccessScopeTagModel{{Key: types.StringValue("env"), Values: set("staging", "prod")}}
+	mysql, postgres := group(), group()
+	mysql.ResourceTypes = set("mysql-database")
+	postgres.ResourceNameContains = set("orders")
+	postgres.Operator = types.StringValue("or")
+	postgres.Permissions = set("readonly")
+	filter.Operator = types.StringValue("and")
+	filter.Groups = []AccessScopeFilterGroupModel{mysql, postgres}
+
+	filterPath := path.Root("filter")
+	query, diags := AccessScopeFilterQuery(ctx, filterPath, object(filter))
+	require.False(t, diags.HasError(), "Error compiling filter: %s", diags.Errors())
+	assert.Equal(t, `integration = "postgresql-prod" and resource_tag["env"] in ("prod", "staging") and resource_type = "mysql-database" and (permission = "readonly" or resource_name contains "orders")`, query.ValueString())
+
+	filter.Integrations = types.SetUnknown(types.StringType)
+	query, diags = AccessScopeFilterQuery(ctx, filterPath, object(filter))
+	require.False(t, diags.HasError(), "Error compiling filter: %s", diags.Errors())
+	assert.True(t, query.IsUnknown())
+
+	_, diags = AccessScopeFilterQuery(ctx, filterPath, object(AccessScopeFilterModel{AccessScopeFilterGroupModel: group()}))
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "at least one condition")
+	assert.Equal(t, filterPath, diags.Errors()[0].(diag.DiagnosticWithPath).Path())
+
+	// A filter that doesn't convert to the model is reported rather than planned as an unknown query.
+	mismatched := types.ObjectValueMust(map[string]attr.Type{"operator": types.StringType}, map[string]attr.Value{"operator": types.StringValue("and")})
+	query, diags = AccessScopeFilterQuery(ctx, filterPath, mismatched)
+	assert.True(t, diags.HasError())
+	assert.True(t, query.IsUnknown())
+}
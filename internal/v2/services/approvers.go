@@ -0,0 +1,444 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Identity condition types that can only be resolved for a specific request, e.g. the manager of the requestor or
+// the owner of the requested resource. Other unresolvable types, such as on-call shifts of context integrations, are
+// detected by not being a user attribute.
+var dynamicConditionTypes = []string{"manager", "owner"}
+
+// ApproverResolver resolves identity conditions to the active Apono users they match, using the users, groups and
+// user attributes of the tenant. Users and groups are listed once, and the members of a group when first needed.
+type ApproverResolver struct {
+	client client.Invoker
+
+	users        []client.UserV3
+	directory    *UserDirectory
+	groups       []client.GroupV1
+	groupMembers map[string][]string
+}
+
+func NewApproverResolver(ctx context.Context, apiClient client.Invoker) (*ApproverResolver, error) {
+	users, err := ListUsers(ctx, apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := ListGroups(ctx, apiClient, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApproverResolver{
+		client:       apiClient,
+		users:        users,
+		directory:    NewUserDirectory(users),
+		groups:       groups,
+		groupMembers: map[string][]string{},
+	}, nil
+}
+
+// ResolvedApprovers holds the primary emails of the users matched by identity conditions. Dynamic reports that the
+// conditions also match identities that are only known per request, which aren't included in Users.
+type ResolvedApprovers struct {
+	Users   map[string]bool
+	Dynamic bool
+}
+
+// Emails returns the sorted primary emails of the resolved users.
+func (a ResolvedApprovers) Emails() []string {
+	emails := make([]string, 0, len(a.Users))
+	for email := range a.Users {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+
+	return emails
+}
+
+// Static reports whether the approvers are fully known ahead of requests.
+func (a ResolvedApprovers) Static() bool {
+	return !a.Dynamic
+}
+
+// ResolveConditions resolves conditions combined with a logical operator. With AND, a condition that can't be
+// resolved ahead of time makes the whole combination dynamic, since no user is known to match it.
+func (r *ApproverResolver) ResolveConditions(ctx context.Context, logicalOperator types.String, conditions []models.AccessFlowCondition) (ResolvedApprovers, error) {
+	and := strings.EqualFold(logicalOperator.ValueString(), "AND")
+
+	result := ResolvedApprovers{Users: map[string]bool{}}
+	for i, condition := range conditions {
+		resolved, err := r.ResolveCondition(ctx, condition)
+		if err != nil {
+			return ResolvedApprovers{}, err
+		}
+
+		result.Dynamic = result.Dynamic || resolved.Dynamic
+		switch {
+		case !and:
+			for email := range resolved.Users {
+				result.Users[email] = true
+			}
+		case i == 0:
+			result.Users = resolved.Users
+		default:
+			for email := range result.Users {
+				if !resolved.Users[email] {
+					delete(result.Users, email)
+				}
+			}
+		}
+	}
+
+	if and && result.Dynamic {
+		result.Users = map[string]bool{}
+	}
+
+	return result, nil
+}
+
+// ResolveCondition resolves a user, group or user attribute condition. Conditions with unknown values, and types that
+// are resolved per request, are dynamic.
+func (r *ApproverResolver) ResolveCondition(ctx context.Context, condition models.AccessFlowCondition) (ResolvedApprovers, error) {
+	dynamic := ResolvedApprovers{Users: map[string]bool{}, Dynamic: true}
+	if !conditionKnown(condition) {
+		return dynamic, nil
+	}
+
+	conditionType := condition.Type.ValueString()
+	sourceIntegration := condition.SourceIntegrationName.ValueString()
+	matchOperator := condition.MatchOperator.ValueString()
+	if matchOperator == "" {
+		matchOperator = "is"
+	}
+
+	var values []string
+	for _, value := range condition.Values.Elements() {
+		if value, ok := value.(types.String); ok && !value.IsNull() {
+			values = append(values, strings.ToLower(strings.TrimSpace(value.ValueString())))
+		}
+	}
+
+	for _, dynamicType := range dynamicConditionTypes {
+		if strings.EqualFold(conditionType, dynamicType) {
+			return dynamic, nil
+		}
+	}
+
+	switch conditionType {
+	case "user":
+		return r.matchUsers(sourceIntegration, func(user *client.UserV3) bool {
+			userValues := []string{strings.ToLower(user.ID)}
+			for _, email := range append([]string{user.Email}, user.EmailAliases...) {
+				userValues = append(userValues, common.NormalizeEmail(email))
+			}
+			return matchValues(userValues, matchOperator, values)
+		}), nil
+	case "group":
+		return r.resolveGroups(ctx, sourceIntegration, matchOperator, values)
+	}
+
+	if !r.isUserAttribute(conditionType) {
+		return dynamic, nil
+	}
+
+	return r.matchUsers(sourceIntegration, func(user *client.UserV3) bool {
+		var userValues []string
+		if value, ok := user.Attributes.Value[conditionType]; ok {
+			userValues = append(userValues, strings.ToLower(value))
+		}
+		return matchValues(userValues, matchOperator, values)
+	}), nil
+}
+
+func (r *ApproverResolver) matchUsers(sourceIntegration string, matches func(user *client.UserV3) bool) ResolvedApprovers {
+	result := ResolvedApprovers{Users: map[string]bool{}}
+	for i := range r.users {
+		user := &r.users[i]
+		if !user.Active || !fromSourceIntegration(user.SourceIntegrationName.Value, sourceIntegration) {
+			continue
+		}
+
+		if matches(user) {
+			result.Users[common.NormalizeEmail(user.Email)] = true
+		}
+	}
+
+	return result
+}
+
+// resolveGroups returns the members of the matching groups. Negative operators return the users that aren't members of
+// any group matching the positive operator, rather than the members of all other groups.
+func (r *ApproverResolver) resolveGroups(ctx context.Context, sourceIntegration, matchOperator string, values []string) (ResolvedApprovers, error) {
+	negated := false
+	switch matchOperator {
+	case "is_not":
+		negated, matchOperator = true, "is"
+	case "does_not_contain":
+		negated, matchOperator = true, "contains"
+	}
+
+	members := map[string]bool{}
+	for _, group := range r.groups {
+		if !fromSourceIntegration(group.SourceIntegrationName.Value, sourceIntegration) {
+			continue
+		}
+
+		groupValues := []string{strings.ToLower(group.ID), strings.ToLower(group.Name)}
+		if sourceID, ok := group.SourceID.Get(); ok {
+			groupValues = append(groupValues, strings.ToLower(sourceID))
+		}
+		if !matchValues(groupValues, matchOperator, values) {
+			continue
+		}
+
+		emails, err := r.listGroupMembers(ctx, group.ID)
+		if err != nil {
+			return ResolvedApprovers{}, err
+		}
+		for _, email := range emails {
+			if user, ok := r.directory.FindByEmail(email); ok && user.Active {
+				members[common.NormalizeEmail(user.Email)] = true
+			}
+		}
+	}
+
+	if !negated {
+		return ResolvedApprovers{Users: members}, nil
+	}
+
+	return r.matchUsers("", func(user *client.UserV3) bool {
+		return !members[common.NormalizeEmail(user.Email)]
+	}), nil
+}
+
+func (r *ApproverResolver) listGroupMembers(ctx context.Context, groupID string) ([]string, error) {
+	if emails, ok := r.groupMembers[groupID]; ok {
+		return emails, nil
+	}
+
+	members, err := ListGroupMembers(ctx, r.client, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members of group %s: %w", groupID, err)
+	}
+
+	emails := make([]string, 0, len(members))
+	for _, member := range members {
+		emails = append(emails, member.Email)
+	}
+	r.groupMembers[groupID] = emails
+
+	return emails, nil
+}
+
+// isUserAttribute reports whether any user has the given attribute, so conditions on it can be resolved.
+func (r *ApproverResolver) isUserAttribute(name string) bool {
+	for _, user := range r.users {
+		if _, ok := user.Attributes.Value[name]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func fromSourceIntegration(name, sourceIntegration string) bool {
+	return sourceIntegration == "" || strings.EqualFold(name, sourceIntegration)
+}
+
+func conditionKnown(condition models.AccessFlowCondition) bool {
+	if condition.Type.IsUnknown() || condition.SourceIntegrationName.IsUnknown() || condition.MatchOperator.IsUnknown() || condition.Values.IsUnknown() {
+		return false
+	}
+
+	for _, value := range condition.Values.Elements() {
+		if value.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AnalyzeApprovers resolves the approvers notified at each tier of an access flow, the approver policy followed by the
+// escalation tiers. It warns about policies that can never be satisfied or that are likely mistakes: an ALL_OF approver
+// group or a whole policy that matches no users, escalation tiers that only notify approvers who were already
+// notified, and a single approver who is also a requestor while requestors can't approve their own requests.
+func AnalyzeApprovers(ctx context.Context, resolver *ApproverResolver, flow *models.AccessFlowV2Model) ([]ResolvedApprovers, diag.Diagnostics, error) {
+	var diags diag.Diagnostics
+	var tiers []ResolvedApprovers
+	notified := map[string]bool{}
+	all := ResolvedApprovers{Users: map[string]bool{}}
+
+	if flow.ApproverPolicy != nil {
+		groupsPath := path.Root("approver_policy").AtName("approver_groups")
+		allOf := strings.EqualFold(flow.ApproverPolicy.ApprovalMode.ValueString(), "ALL_OF")
+
+		tier := ResolvedApprovers{Users: map[string]bool{}}
+		for _, group := range flow.ApproverPolicy.ApproverGroups {
+			resolved, err := resolver.ResolveConditions(ctx, group.LogicalOperator, group.Approvers)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if allOf && resolved.Static() && len(resolved.Users) == 0 {
+				diags.AddAttributeWarning(groupsPath, "Unsatisfiable Approver Policy", fmt.Sprintf(
+					"The approver group %s matches no active Apono users, so with approval_mode ALL_OF requests can never be approved.",
+					describeApproverGroup(group),
+				))
+			}
+
+			tier.merge(resolved)
+		}
+
+		if !allOf && tier.Static() && len(tier.Users) == 0 && len(flow.ApproverPolicy.ApproverGroups) > 0 {
+			diags.AddAttributeWarning(groupsPath, "Unsatisfiable Approver Policy",
+				"The approver groups match no active Apono users, so requests can never be approved.")
+		}
+
+		tiers = append(tiers, tier)
+		for email := range tier.Users {
+			notified[email] = true
+		}
+		all.merge(tier)
+	}
+
+	if flow.EscalationPolicy != nil {
+		for i, group := range flow.EscalationPolicy.ApproverGroups {
+			groupPath := path.Root("escalation_policy").AtName("approver_groups").AtListIndex(i)
+			resolved, err := resolver.ResolveConditions(ctx, group.LogicalOperator, group.Approvers)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if resolved.Static() {
+				switch {
+				case len(resolved.Users) == 0:
+					diags.AddAttributeWarning(groupPath, "Empty Escalation Tier", fmt.Sprintf(
+						"Escalation tier %d matches no active Apono users, so escalating to it notifies no one.", i+1,
+					))
+				case isSubset(resolved.Users, notified):
+					diags.AddAttributeWarning(groupPath, "Redundant Escalation Tier", fmt.Sprintf(
+						"Escalation tier %d only notifies approvers who were already notified before it: %s.",
+						i+1, strings.Join(resolved.Emails(), ", "),
+					))
+				}
+			}
+
+			tiers = append(tiers, resolved)
+			for email := range resolved.Users {
+				notified[email] = true
+			}
+			all.merge(resolved)
+		}
+	}
+
+	if flow.Settings != nil && flow.Settings.RequesterCannotApproveSelf.ValueBool() && flow.Requestors != nil &&
+		all.Static() && len(all.Users) == 1 {
+		requestors, err := resolver.ResolveConditions(ctx, flow.Requestors.LogicalOperator, flow.Requestors.Conditions)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		approver := all.Emails()[0]
+		if requestors.Users[approver] {
+			diags.AddAttributeWarning(
+				path.Root("settings").AtName("requester_cannot_approve_self"),
+				"Unsatisfiable Approver Policy",
+				fmt.Sprintf("%q is the only approver and is also a requestor, so with requester_cannot_approve_self the requests of %q can never be approved.", approver, approver),
+			)
+		}
+	}
+
+	return tiers, diags, nil
+}
+
+// EffectiveApprovers converts the tiers returned by AnalyzeApprovers to the value of the effective_approvers attribute. The approver policy
+// is tier 0 and escalation tiers are numbered from 1, even when the access flow has no approver policy.
+func EffectiveApprovers(ctx context.Context, flow *models.AccessFlowV2Model, tiers []ResolvedApprovers) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	first := 0
+	if flow.ApproverPolicy == nil {
+		first = 1
+	}
+
+	elements := make([]attr.Value, 0, len(tiers))
+	for i, tier := range tiers {
+		users, d := types.SetValueFrom(ctx, types.StringType, tier.Emails())
+		diags.Append(d...)
+
+		element, d := types.ObjectValue(models.EffectiveApproversTierType.AttrTypes, map[string]attr.Value{
+			"tier":    types.Int32Value(int32(first + i)),
+			"users":   users,
+			"dynamic": types.BoolValue(tier.Dynamic),
+		})
+		diags.Append(d...)
+		elements = append(elements, element)
+	}
+	if diags.HasError() {
+		return types.ListNull(models.EffectiveApproversTierType), diags
+	}
+
+	list, d := types.ListValue(models.EffectiveApproversTierType, elements)
+	diags.Append(d...)
+
+	return list, diags
+}
+
+func (a *ResolvedApprovers) merge(other ResolvedApprovers) {
+	for email := range other.Users {
+		a.Users[email] = true
+	}
+	a.Dynamic = a.Dynamic || other.Dynamic
+}
+
+func isSubset(users, of map[string]bool) bool {
+	for email := range users {
+		if !of[email] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// describeApproverGroup identifies an approver group in messages, since approver groups of the approver policy are a
+// set and can't be reported by index.
+func describeApproverGroup(group models.AccessFlowApproverGroup) string {
+	conditions := make([]string, 0, len(group.Approvers))
+	for _, condition := range group.Approvers {
+		var values []string
+		for _, value := range condition.Values.Elements() {
+			if value, ok := value.(types.String); ok {
+				values = append(values, value.ValueString())
+			}
+		}
+
+		matchOperator := condition.MatchOperator.ValueString()
+		if matchOperator == "" {
+			matchOperator = "is"
+		}
+		conditions = append(conditions, fmt.Sprintf("%s %s [%s]", condition.Type.ValueString(), matchOperator, strings.Join(values, ", ")))
+	}
+
+	separator := " OR "
+	if strings.EqualFold(group.LogicalOperator.ValueString(), "AND") {
+		separator = " AND "
+	}
+
+	return "(" + strings.Join(conditions, separator) + ")"
+}
@@ -0,0 +1,240 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/mocks"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/testcommon"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestApproverResolver(t *testing.T) *ApproverResolver {
+	mockInvoker := mocks.NewInvoker(t)
+
+	okta := client.OptNilString{Value: "Okta", Set: true}
+	users := []client.UserV3{
+		{ID: "user-1", Email: "alice@example.com", Active: true, SourceIntegrationName: okta,
+			Attributes: client.OptNilUserV3Attributes{Value: map[string]string{"department": "Security"}, Set: true}},
+		{ID: "user-2", Email: "bob@example.com", EmailAliases: []string{"robert@example.com"}, Active: true,
+			Attributes: client.OptNilUserV3Attributes{Value: map[string]string{"department": "Platform"}, Set: true}},
+		{ID: "user-3", Email: "carol@example.com", Active: true},
+		{ID: "user-4", Email: "dave@example.com", Active: false,
+			Attributes: client.OptNilUserV3Attributes{Value: map[string]string{"department": "Security"}, Set: true}},
+	}
+	groups := []client.GroupV1{
+		{ID: "group-1", Name: "Security Team", SourceID: client.OptNilString{Value: "00g1", Set: true}, SourceIntegrationName: okta},
+		{ID: "group-2", Name: "Empty Team"},
+	}
+
+	mockInvoker.EXPECT().ListUsersV3(mock.Anything, client.ListUsersV3Params{}).
+		Return(&client.PublicApiListResponseUserPublicV3Model{Items: users}, nil).Once()
+	mockInvoker.EXPECT().ListGroupsV1(mock.Anything, client.ListGroupsV1Params{}).
+		Return(&client.PublicApiListResponseGroupPublicV1Model{Items: groups}, nil).Once()
+	mockInvoker.EXPECT().ListGroupMembersV1(mock.Anything, client.ListGroupMembersV1Params{ID: "group-1"}).
+		Return(&client.PublicApiListResponseGroupMemberPublicV1Model{Items: []client.GroupMemberV1{
+			{Email: "Alice@example.com"}, {Email: "robert@example.com"}, {Email: "dave@example.com"},
+		}}, nil).Maybe()
+	mockInvoker.EXPECT().ListGroupMembersV1(mock.Anything, client.ListGroupMembersV1Params{ID: "group-2"}).
+		Return(&client.PublicApiListResponseGroupMemberPublicV1Model{}, nil).Maybe()
+
+	resolver, err := NewApproverResolver(t.Context(), mockInvoker)
+	require.NoError(t, err)
+
+	return resolver
+}
+
+func testCondition(t *testing.T, conditionType, matchOperator string, values ...string) models.AccessFlowCondition {
+	return models.AccessFlowCondition{
+		SourceIntegrationName: types.StringNull(),
+		Type:                  types.StringValue(conditionType),
+		MatchOperator:         types.StringValue(matchOperator),
+		Values:                testcommon.CreateTestStringList(t, values),
+	}
+}
+
+func testApproverGroup(logicalOperator string, conditions ...models.AccessFlowCondition) models.AccessFlowApproverGroup {
+	return models.AccessFlowApproverGroup{LogicalOperator: types.StringValue(logicalOperator), Approvers: conditions}
+}
+
+func TestApproverResolverResolveCondition(t *testing.T) {
+	resolver := newTestApproverResolver(t)
+
+	oktaUser := testCondition(t, "user", "is", "alice@example.com", "bob@example.com")
+	oktaUser.SourceIntegrationName = types.StringValue("okta")
+
+	tests := []struct {
+		name      string
+		condition models.AccessFlowCondition
+		expected  []string
+		dynamic   bool
+	}{
+		{name: "user by email alias", condition: testCondition(t, "user", "is", "Robert@example.com"), expected: []string{"bob@example.com"}},
+		{name: "user by id", condition: testCondition(t, "user", "is", "user-3"), expected: []string{"carol@example.com"}},
+		{name: "user is not", condition: testCondition(t, "user", "is_not", "alice@example.com"), expected: []string{"bob@example.com", "carol@example.com"}},
+		{name: "user from source integration", condition: oktaUser, expected: []string{"alice@example.com"}},
+		{name: "inactive user", condition: testCondition(t, "user", "is", "dave@example.com"), expected: []string{}},
+		{name: "group by name", condition: testCondition(t, "group", "is", "Security Team"), expected: []string{"alice@example.com", "bob@example.com"}},
+		{name: "group by source id", condition: testCondition(t, "group", "is", "00g1"), expected: []string{"alice@example.com", "bob@example.com"}},
+		{name: "group is not", condition: testCondition(t, "group", "is_not", "group-1"), expected: []string{"carol@example.com"}},
+		{name: "empty group", condition: testCondition(t, "group", "is", "Empty Team"), expected: []string{}},
+		{name: "attribute", condition: testCondition(t, "department", "is", "security"), expected: []string{"alice@example.com"}},
+		{name: "manager", condition: testCondition(t, "manager", "is"), expected: []string{}, dynamic: true},
+		{name: "owner", condition: testCondition(t, "Owner", "is"), expected: []string{}, dynamic: true},
+		{name: "shift", condition: testCondition(t, "pagerduty_shift", "is", "Primary"), expected: []string{}, dynamic: true},
+		{name: "unknown values", condition: models.AccessFlowCondition{Type: types.StringValue("user"), Values: types.ListUnknown(types.StringType)}, expected: []string{}, dynamic: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolved, err := resolver.ResolveCondition(t.Context(), tt.condition)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resolved.Emails())
+			assert.Equal(t, tt.dynamic, resolved.Dynamic)
+		})
+	}
+}
+
+func TestApproverResolverResolveConditions(t *testing.T) {
+	resolver := newTestApproverResolver(t)
+
+	group := testCondition(t, "group", "is", "Security Team")
+	attribute := testCondition(t, "department", "is", "Security")
+	manager := testCondition(t, "manager", "is")
+
+	resolved, err := resolver.ResolveConditions(t.Context(), types.StringValue("AND"), []models.AccessFlowCondition{group, attribute})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice@example.com"}, resolved.Emails())
+	assert.False(t, resolved.Dynamic)
+
+	resolved, err = resolver.ResolveConditions(t.Context(), types.StringValue("OR"), []models.AccessFlowCondition{attribute, manager})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alice@example.com"}, resolved.Emails())
+	assert.True(t, resolved.Dynamic)
+
+	resolved, err = resolver.ResolveConditions(t.Context(), types.StringValue("AND"), []models.AccessFlowCondition{attribute, manager})
+	require.NoError(t, err)
+	assert.Empty(t, resolved.Emails())
+	assert.True(t, resolved.Dynamic)
+}
+
+func TestAnalyzeApprovers(t *testing.T) {
+	alice := testCondition(t, "user", "is", "alice@example.com")
+	securityTeam := testCondition(t, "group", "is", "Security Team")
+	emptyTeam := testCondition(t, "group", "is", "Empty Team")
+	manager := testCondition(t, "manager", "is")
+
+	warnings := func(diags diag.Diagnostics) map[string]string {
+		result := map[string]string{}
+		for _, d := range diags {
+			result[d.(diag.DiagnosticWithPath).Path().String()] = d.Detail()
+		}
+		return result
+	}
+
+	t.Run("AllOfEmptyGroup", func(t *testing.T) {
+		flow := &models.AccessFlowV2Model{
+			ApproverPolicy: &models.AccessFlowApproverPolicy{
+				ApprovalMode:   types.StringValue("ALL_OF"),
+				ApproverGroups: []models.AccessFlowApproverGroup{testApproverGroup("OR", alice), testApproverGroup("OR", emptyTeam)},
+			},
+		}
+
+		tiers, diags, err := AnalyzeApprovers(t.Context(), newTestApproverResolver(t), flow)
+		require.NoError(t, err)
+		require.Len(t, tiers, 1)
+		assert.Equal(t, []string{"alice@example.com"}, tiers[0].Emails())
+		assert.Equal(t, map[string]string{
+			"approver_policy.approver_groups": "The approver group (group is [Empty Team]) matches no active Apono users, so with approval_mode ALL_OF requests can never be approved.",
+		}, warnings(diags))
+	})
+
+	t.Run("AnyOfWithoutUsers", func(t *testing.T) {
+		flow := &models.AccessFlowV2Model{
+			ApproverPolicy: &models.AccessFlowApproverPolicy{
+				ApprovalMode:   types.StringValue("ANY_OF"),
+				ApproverGroups: []models.AccessFlowApproverGroup{testApproverGroup("OR", emptyTeam)},
+			},
+		}
+
+		_, diags, err := AnalyzeApprovers(t.Context(), newTestApproverResolver(t), flow)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"approver_policy.approver_groups": "The approver groups match no active Apono users, so requests can never be approved.",
+		}, warnings(diags))
+	})
+
+	t.Run("RedundantEscalationTiers", func(t *testing.T) {
+		flow := &models.AccessFlowV2Model{
+			ApproverPolicy: &models.AccessFlowApproverPolicy{
+				ApprovalMode:   types.StringValue("ANY_OF"),
+				ApproverGroups: []models.AccessFlowApproverGroup{testApproverGroup("OR", securityTeam)},
+			},
+			EscalationPolicy: &models.EscalationPolicyModel{
+				ApproverGroups: []models.AccessFlowApproverGroup{
+					testApproverGroup("OR", alice),
+					testApproverGroup("OR", emptyTeam),
+					testApproverGroup("OR", alice, manager),
+				},
+			},
+		}
+
+		tiers, diags, err := AnalyzeApprovers(t.Context(), newTestApproverResolver(t), flow)
+		require.NoError(t, err)
+		require.Len(t, tiers, 4)
+		assert.True(t, tiers[3].Dynamic)
+		assert.Equal(t, map[string]string{
+			"escalation_policy.approver_groups[0]": "Escalation tier 1 only notifies approvers who were already notified before it: alice@example.com.",
+			"escalation_policy.approver_groups[1]": "Escalation tier 2 matches no active Apono users, so escalating to it notifies no one.",
+		}, warnings(diags))
+
+		effectiveApprovers, d := EffectiveApprovers(t.Context(), flow, tiers)
+		require.False(t, d.HasError())
+		require.Len(t, effectiveApprovers.Elements(), 4)
+		assert.Equal(t, types.Int32Value(3), effectiveApprovers.Elements()[3].(types.Object).Attributes()["tier"])
+	})
+
+	t.Run("SingleApproverIsRequestor", func(t *testing.T) {
+		flow := &models.AccessFlowV2Model{
+			ApproverPolicy: &models.AccessFlowApproverPolicy{
+				ApprovalMode:   types.StringValue("ANY_OF"),
+				ApproverGroups: []models.AccessFlowApproverGroup{testApproverGroup("OR", alice)},
+			},
+			Requestors: &models.AccessFlowRequestorsModel{
+				LogicalOperator: types.StringValue("OR"),
+				Conditions:      []models.AccessFlowCondition{securityTeam},
+			},
+			Settings: &models.AccessFlowSettingsModel{RequesterCannotApproveSelf: types.BoolValue(true)},
+		}
+
+		_, diags, err := AnalyzeApprovers(t.Context(), newTestApproverResolver(t), flow)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"settings.requester_cannot_approve_self": `"alice@example.com" is the only approver and is also a requestor, so with requester_cannot_approve_self the requests of "alice@example.com" can never be approved.`,
+		}, warnings(diags))
+
+		flow.Settings.RequesterCannotApproveSelf = types.BoolValue(false)
+		_, diags, err = AnalyzeApprovers(t.Context(), newTestApproverResolver(t), flow)
+		require.NoError(t, err)
+		assert.Empty(t, diags)
+	})
+}
+
+func TestEffectiveApproversWithoutApproverPolicy(t *testing.T) {
+	flow := &models.AccessFlowV2Model{EscalationPolicy: &models.EscalationPolicyModel{}}
+	tiers := []ResolvedApprovers{{Users: map[string]bool{"alice@example.com": true}}}
+
+	effectiveApprovers, diags := EffectiveApprovers(t.Context(), flow, tiers)
+	require.False(t, diags.HasError())
+	require.Len(t, effectiveApprovers.Elements(), 1)
+
+	attributes := effectiveApprovers.Elements()[0].(types.Object).Attributes()
+	assert.Equal(t, types.Int32Value(1), attributes["tier"])
+	assert.Equal(t, types.BoolValue(false), attributes["dynamic"])
+	assert.Equal(t, testcommon.CreateTestStringSet(t, []string{"alice@example.com"}), attributes["users"])
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+)
+
+// ConnectorDisconnectedStatus is the status of a connector that isn't connected to Apono.
+const ConnectorDisconnectedStatus = "DISCONNECTED"
+
+// connectorCloudProviders maps the prefixes of cloud integration types to the cloud provider type of the connectors
+// that can manage them. Other integration types, such as databases, can be managed by connectors on any cloud.
+var connectorCloudProviders = map[string]string{
+	"aws-":   "AWS",
+	"gcp-":   "GCP",
+	"azure-": "AZURE",
+}
+
+func ListConnectors(ctx context.Context, apiClient client.Invoker) ([]client.ConnectorV3, error) {
+	allConnectors := []client.ConnectorV3{}
+	pageToken := ""
+
+	for {
+		params := client.ListConnectorsV3Params{}
+		if pageToken != "" {
+			params.PageToken.SetTo(pageToken)
+		}
+
+		resp, err := apiClient.ListConnectorsV3(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list connectors: %w", err)
+		}
+
+		allConnectors = append(allConnectors, resp.Items...)
+
+		if resp.Pagination.NextPageToken.Value == "" {
+			break
+		}
+
+		pageToken = resp.Pagination.NextPageToken.Value
+	}
+
+	// Sort connectors by id for consistency
+	sort.Slice(allConnectors, func(i, j int) bool {
+		return allConnectors[i].ID < allConnectors[j].ID
+	})
+
+	return allConnectors, nil
+}
+
+// FindConnectorByName returns the connector with exactly the given name. It fails when no connector or more than
+// one connector has that name, suggesting the closest name when there's no match.
+func FindConnectorByName(connectors []client.ConnectorV3, name string) (*client.ConnectorV3, error) {
+	var matches []client.ConnectorV3
+	names := make([]string, 0, len(connectors))
+	for _, connector := range connectors {
+		names = append(names, connector.Name)
+		if connector.Name == name {
+			matches = append(matches, connector)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no connector named %q was found.%s", name, common.DidYouMean(name, names))
+	case 1:
+		return &matches[0], nil
+	}
+
+	return nil, fmt.Errorf("%d connectors are named %q, use connector_id instead", len(matches), name)
+}
+
+// ConnectorProblems returns the reasons why a connector can't be expected to manage an integration of the given
+// type: it's disconnected, or it's installed on a different cloud provider than a cloud integration requires.
+func ConnectorProblems(connector *client.ConnectorV3, integrationType string) []string {
+	var problems []string
+
+	if strings.EqualFold(connector.Status, ConnectorDisconnectedStatus) {
+		lastConnected := "it has never connected"
+		if value, ok := connector.LastConnected.Get(); ok {
+			lastConnected = fmt.Sprintf("it last connected at %s", time.Time(value).UTC().Format(time.RFC3339))
+		}
+		problems = append(problems, fmt.Sprintf("Connector %q is disconnected, %s.", connector.Name, lastConnected))
+	}
+
+	if expected := expectedCloudProvider(integrationType); expected != "" && isCloudProvider(connector.CloudProviderType) &&
+		!strings.EqualFold(connector.CloudProviderType, expected) {
+		problems = append(problems, fmt.Sprintf(
+			"Connector %q is installed on %s, but %q integrations require a connector installed on %s.",
+			connector.Name, connector.CloudProviderType, integrationType, expected,
+		))
+	}
+
+	return problems
+}
+
+func expectedCloudProvider(integrationType string) string {
+	for prefix, cloudProvider := range connectorCloudProviders {
+		if strings.HasPrefix(strings.ToLower(integrationType), prefix) {
+			return cloudProvider
+		}
+	}
+
+	return ""
+}
+
+// isCloudProvider reports whether the cloud provider type of a connector is one of the known cloud providers. Other
+// values, e.g. for connectors installed on plain Kubernetes clusters, aren't compared.
+func isCloudProvider(cloudProviderType string) bool {
+	for _, cloudProvider := range connectorCloudProviders {
+		if strings.EqualFold(cloudProviderType, cloudProvider) {
+			return true
+		}
+	}
+
+	return false
+}
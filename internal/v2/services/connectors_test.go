@@ -0,0 +1,101 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListConnectors(t *testing.T) {
+	ctx := t.Context()
+	mockInvoker := mocks.NewInvoker(t)
+
+	firstPage := &client.PublicApiListResponseConnectorPublicV3Model{
+		Items: []client.ConnectorV3{{ID: "connector-2", Name: "prod-eks"}},
+	}
+	firstPage.Pagination.NextPageToken.SetTo("next")
+	secondParams := client.ListConnectorsV3Params{}
+	secondParams.PageToken.SetTo("next")
+
+	mockInvoker.EXPECT().ListConnectorsV3(mock.Anything, client.ListConnectorsV3Params{}).Return(firstPage, nil).Once()
+	mockInvoker.EXPECT().ListConnectorsV3(mock.Anything, secondParams).Return(&client.PublicApiListResponseConnectorPublicV3Model{
+		Items: []client.ConnectorV3{{ID: "connector-1", Name: "prod-gke"}},
+	}, nil).Once()
+
+	connectors, err := ListConnectors(ctx, mockInvoker)
+
+	require.NoError(t, err)
+	require.Len(t, connectors, 2)
+	assert.Equal(t, "connector-1", connectors[0].ID)
+	assert.Equal(t, "connector-2", connectors[1].ID)
+}
+
+func TestFindConnectorByName(t *testing.T) {
+	connectors := []client.ConnectorV3{
+		{ID: "connector-1", Name: "prod-eks"},
+		{ID: "connector-2", Name: "staging-eks"},
+		{ID: "connector-3", Name: "shared"},
+		{ID: "connector-4", Name: "shared"},
+	}
+
+	connector, err := FindConnectorByName(connectors, "staging-eks")
+	require.NoError(t, err)
+	assert.Equal(t, "connector-2", connector.ID)
+
+	_, err = FindConnectorByName(connectors, "prod-ekss")
+	assert.ErrorContains(t, err, `Did you mean "prod-eks"?`)
+
+	_, err = FindConnectorByName(connectors, "shared")
+	assert.ErrorContains(t, err, `2 connectors are named "shared"`)
+}
+
+func TestConnectorProblems(t *testing.T) {
+	lastConnected := client.OptNilApiInstant{}
+	lastConnected.SetTo(client.ApiInstant(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)))
+
+	tests := []struct {
+		name            string
+		connector       client.ConnectorV3
+		integrationType string
+		expected        []string
+	}{
+		{
+			name:            "connected connector on the same cloud",
+			connector:       client.ConnectorV3{Name: "prod-eks", Status: "CONNECTED", CloudProviderType: "AWS"},
+			integrationType: "aws-account",
+		},
+		{
+			name:            "disconnected connector",
+			connector:       client.ConnectorV3{Name: "prod-eks", Status: "DISCONNECTED", CloudProviderType: "AWS", LastConnected: lastConnected},
+			integrationType: "postgresql",
+			expected:        []string{`Connector "prod-eks" is disconnected, it last connected at 2026-03-01T12:00:00Z.`},
+		},
+		{
+			name:            "connector on another cloud",
+			connector:       client.ConnectorV3{Name: "prod-gke", Status: "CONNECTED", CloudProviderType: "GCP"},
+			integrationType: "aws-account",
+			expected:        []string{`Connector "prod-gke" is installed on GCP, but "aws-account" integrations require a connector installed on AWS.`},
+		},
+		{
+			name:            "database integration on any cloud",
+			connector:       client.ConnectorV3{Name: "prod-gke", Status: "CONNECTED", CloudProviderType: "GCP"},
+			integrationType: "mysql",
+		},
+		{
+			name:            "connector without a known cloud provider",
+			connector:       client.ConnectorV3{Name: "on-prem", Status: "CONNECTED", CloudProviderType: "KUBERNETES"},
+			integrationType: "azure-subscription",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ConnectorProblems(&tt.connector, tt.integrationType))
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+)
+
+// ImportByNamePrefix marks import IDs that reference an object by its name instead of its ID, e.g. "name:Prod DB Access".
+const ImportByNamePrefix = "name:"
+
+// ResolveImportID returns the ID of the object to import. Import IDs without the ImportByNamePrefix are returned as is,
+// otherwise listByName is called with the name and must return the IDs of all objects with exactly that name.
+func ResolveImportID(importID string, resourceType string, listByName func(name string) ([]string, error)) (string, error) {
+	name, ok := strings.CutPrefix(importID, ImportByNamePrefix)
+	if !ok {
+		return importID, nil
+	}
+
+	if name == "" {
+		return "", fmt.Errorf("import ID %q is missing the %s name", importID, resourceType)
+	}
+
+	ids, err := listByName(name)
+	if err != nil {
+		return "", fmt.Errorf("failed to look up %s with name '%s': %w", resourceType, name, err)
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", common.NewNotFoundByNameError(resourceType, name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("found %d %ss with name '%s' (IDs: %s), import by ID instead", len(ids), resourceType, name, strings.Join(ids, ", "))
+	}
+}
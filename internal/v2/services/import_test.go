@@ -0,0 +1,56 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveImportID(t *testing.T) {
+	tests := []struct {
+		name         string
+		importID     string
+		ids          []string
+		listErr      error
+		expected     string
+		expectedName string
+		errorMsg     string
+	}{
+		{name: "plain ID", importID: "abc-123", expected: "abc-123"},
+		{name: "single match", importID: "name:Prod DB Access", ids: []string{"abc-123"}, expected: "abc-123", expectedName: "Prod DB Access"},
+		{name: "name with colon", importID: "name:team:prod", ids: []string{"abc-123"}, expected: "abc-123", expectedName: "team:prod"},
+		{name: "no match", importID: "name:missing", expectedName: "missing", errorMsg: "bundle with name 'missing' not found"},
+		{name: "multiple matches", importID: "name:dup", ids: []string{"a", "b"}, expectedName: "dup", errorMsg: "found 2 bundles with name 'dup' (IDs: a, b)"},
+		{name: "empty name", importID: "name:", errorMsg: "missing the bundle name"},
+		{name: "list error", importID: "name:prod", listErr: errors.New("boom"), expectedName: "prod", errorMsg: "boom"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var lookedUp string
+			id, err := ResolveImportID(tt.importID, "bundle", func(name string) ([]string, error) {
+				lookedUp = name
+				return tt.ids, tt.listErr
+			})
+
+			assert.Equal(t, tt.expectedName, lookedUp)
+
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, id)
+		})
+	}
+
+	t.Run("not found error is detectable", func(t *testing.T) {
+		_, err := ResolveImportID("name:missing", "bundle", func(string) ([]string, error) { return nil, nil })
+		assert.ErrorIs(t, err, common.ErrNotFoundByName)
+	})
+}
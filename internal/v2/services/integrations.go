@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 
 	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
@@ -56,3 +57,25 @@ func ListIntegrations(ctx context.Context, apiClient client.Invoker, integration
 
 	return allIntegrations, nil
 }
+
+// ListIntegrationPermissions retrieves the names of the permissions an integration offers for a resource type. The
+// endpoint isn't paginated by token and returns all permissions at once.
+func ListIntegrationPermissions(ctx context.Context, apiClient client.Invoker, integrationID string, resourceType string) ([]string, error) {
+	params := client.GetIntegrationPermissionsParams{ID: integrationID}
+	params.ResourceType.SetTo(resourceType)
+
+	resp, err := apiClient.GetIntegrationPermissions(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list permissions of integration %s: %w", integrationID, err)
+	}
+
+	permissions := []string{}
+	for _, permission := range resp.Data {
+		if permission.ResourceType == resourceType && !slices.Contains(permissions, permission.Name) {
+			permissions = append(permissions, permission.Name)
+		}
+	}
+	sort.Strings(permissions)
+
+	return permissions, nil
+}
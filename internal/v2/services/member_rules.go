@@ -0,0 +1,144 @@
+package services
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The user properties that group member rules can match.
+const (
+	MemberRuleTypeAttribute         = "attribute"
+	MemberRuleTypeRole              = "role"
+	MemberRuleTypeEmail             = "email"
+	MemberRuleTypeSourceIntegration = "source_integration"
+)
+
+var MemberRuleTypes = []string{MemberRuleTypeAttribute, MemberRuleTypeRole, MemberRuleTypeEmail, MemberRuleTypeSourceIntegration}
+
+// MemberRulesKnown reports whether all values of the rules are known, so the rules can be evaluated.
+func MemberRulesKnown(rules []models.GroupMemberRuleModel) bool {
+	for _, rule := range rules {
+		if rule.Type.IsUnknown() || rule.AttributeName.IsUnknown() || rule.MatchOperator.IsUnknown() || rule.Values.IsUnknown() {
+			return false
+		}
+
+		for _, value := range rule.Values.Elements() {
+			if value.IsUnknown() {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// MatchMemberRules returns the sorted primary emails of the active users that match all rules.
+func MatchMemberRules(users []client.UserV3, rules []models.GroupMemberRuleModel) []string {
+	emails := []string{}
+	for i := range users {
+		if !users[i].Active {
+			continue
+		}
+
+		matches := true
+		for _, rule := range rules {
+			if !matchesMemberRule(&users[i], rule) {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			emails = append(emails, users[i].Email)
+		}
+	}
+	sort.Strings(emails)
+
+	return emails
+}
+
+// matchesMemberRule compares the values of the rule with the values of the user property it matches. Emails are
+// compared ignoring case.
+func matchesMemberRule(user *client.UserV3, rule models.GroupMemberRuleModel) bool {
+	var ruleValues []string
+	for _, value := range rule.Values.Elements() {
+		if value, ok := value.(types.String); ok && !value.IsNull() {
+			ruleValues = append(ruleValues, value.ValueString())
+		}
+	}
+
+	if rule.Type.ValueString() == MemberRuleTypeEmail {
+		for i, value := range ruleValues {
+			ruleValues[i] = common.NormalizeEmail(value)
+		}
+	}
+
+	return matchValues(memberRuleUserValues(user, rule), rule.MatchOperator.ValueString(), ruleValues)
+}
+
+// matchValues compares the values of a property with the values of a condition using one of common.MatchOperators.
+// Properties can have several values, e.g. the roles of a user, so "is" matches when any of them equals any of the
+// condition values and "is_not" matches when none of them does.
+func matchValues(values []string, matchOperator string, conditionValues []string) bool {
+	anyMatch := func(compare func(value, conditionValue string) bool) bool {
+		for _, value := range values {
+			for _, conditionValue := range conditionValues {
+				if compare(value, conditionValue) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	equals := func(value, conditionValue string) bool { return value == conditionValue }
+
+	switch matchOperator {
+	case "is_not":
+		return !anyMatch(equals)
+	case "contains":
+		return anyMatch(strings.Contains)
+	case "does_not_contain":
+		return !anyMatch(strings.Contains)
+	case "starts_with":
+		return anyMatch(strings.HasPrefix)
+	}
+
+	return anyMatch(equals)
+}
+
+func memberRuleUserValues(user *client.UserV3, rule models.GroupMemberRuleModel) []string {
+	switch rule.Type.ValueString() {
+	case MemberRuleTypeAttribute:
+		if attributes, ok := user.Attributes.Get(); ok {
+			if value, ok := attributes[rule.AttributeName.ValueString()]; ok {
+				return []string{value}
+			}
+		}
+		return nil
+	case MemberRuleTypeRole:
+		return user.Roles
+	case MemberRuleTypeEmail:
+		emails := []string{common.NormalizeEmail(user.Email)}
+		for _, alias := range user.EmailAliases {
+			emails = append(emails, common.NormalizeEmail(alias))
+		}
+		return emails
+	case MemberRuleTypeSourceIntegration:
+		var values []string
+		if id, ok := user.SourceIntegrationID.Get(); ok {
+			values = append(values, id)
+		}
+		if name, ok := user.SourceIntegrationName.Get(); ok {
+			values = append(values, name)
+		}
+		return values
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/testcommon"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchMemberRules(t *testing.T) {
+	attributes := func(values map[string]string) client.OptNilUserV3Attributes {
+		return client.OptNilUserV3Attributes{Value: values, Set: true}
+	}
+	okta := client.OptNilString{Value: "Okta", Set: true}
+
+	users := []client.UserV3{
+		{ID: "user-1", Email: "alice@example.com", Active: true, Roles: []string{"User"}, Attributes: attributes(map[string]string{"department": "Platform"}), SourceIntegrationName: okta},
+		{ID: "user-2", Email: "bob@example.com", Active: true, Roles: []string{"User", "Admin"}, Attributes: attributes(map[string]string{"department": "Platform"}), SourceIntegrationName: okta},
+		{ID: "user-3", Email: "carol@example.com", Active: false, Roles: []string{"User"}, Attributes: attributes(map[string]string{"department": "Platform"})},
+		{ID: "user-4", Email: "dave@example.com", EmailAliases: []string{"david@contractors.example.com"}, Active: true, Roles: []string{"User"}, Attributes: attributes(map[string]string{"department": "Platform Security"})},
+		{ID: "user-5", Email: "erin@example.com", Active: true, Roles: []string{"User"}},
+	}
+
+	rule := func(ruleType, attributeName, matchOperator string, values ...string) models.GroupMemberRuleModel {
+		model := models.GroupMemberRuleModel{
+			Type:          types.StringValue(ruleType),
+			AttributeName: types.StringNull(),
+			MatchOperator: types.StringValue(matchOperator),
+			Values:        testcommon.CreateTestStringList(t, values),
+		}
+		if attributeName != "" {
+			model.AttributeName = types.StringValue(attributeName)
+		}
+		return model
+	}
+
+	tests := []struct {
+		name     string
+		rules    []models.GroupMemberRuleModel
+		expected []string
+	}{
+		{
+			name:     "attribute is",
+			rules:    []models.GroupMemberRuleModel{rule(MemberRuleTypeAttribute, "department", "is", "Platform")},
+			expected: []string{"alice@example.com", "bob@example.com"},
+		},
+		{
+			name: "attribute is and role is not",
+			rules: []models.GroupMemberRuleModel{
+				rule(MemberRuleTypeAttribute, "department", "is", "Platform"),
+				rule(MemberRuleTypeRole, "", "is_not", "Admin"),
+			},
+			expected: []string{"alice@example.com"},
+		},
+		{
+			name:     "attribute starts with",
+			rules:    []models.GroupMemberRuleModel{rule(MemberRuleTypeAttribute, "department", "starts_with", "Platform")},
+			expected: []string{"alice@example.com", "bob@example.com", "dave@example.com"},
+		},
+		{
+			name:     "attribute does not contain, including users without the attribute",
+			rules:    []models.GroupMemberRuleModel{rule(MemberRuleTypeAttribute, "department", "does_not_contain", "Platform")},
+			expected: []string{"erin@example.com"},
+		},
+		{
+			name:     "email alias contains",
+			rules:    []models.GroupMemberRuleModel{rule(MemberRuleTypeEmail, "", "contains", "@Contractors.")},
+			expected: []string{"dave@example.com"},
+		},
+		{
+			name:     "source integration",
+			rules:    []models.GroupMemberRuleModel{rule(MemberRuleTypeSourceIntegration, "", "is", "Okta")},
+			expected: []string{"alice@example.com", "bob@example.com"},
+		},
+		{
+			name:     "no match",
+			rules:    []models.GroupMemberRuleModel{rule(MemberRuleTypeRole, "", "is", "Auditor")},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, MatchMemberRules(users, tt.rules))
+		})
+	}
+}
+
+func TestMemberRulesKnown(t *testing.T) {
+	rule := models.GroupMemberRuleModel{
+		Type:          types.StringValue(MemberRuleTypeRole),
+		AttributeName: types.StringNull(),
+		MatchOperator: types.StringValue("is"),
+		Values:        testcommon.CreateTestStringList(t, []string{"Admin"}),
+	}
+	assert.True(t, MemberRulesKnown([]models.GroupMemberRuleModel{rule}))
+
+	rule.Values = types.ListUnknown(types.StringType)
+	assert.False(t, MemberRulesKnown([]models.GroupMemberRuleModel{rule}))
+}
@@ -0,0 +1,312 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ReferenceChecker checks the integrations, permissions, bundles and access scopes referenced by access flows and
+// bundles against the tenant, so that typos are reported at plan time instead of failing the apply. Each kind of object
+// is listed at most once, when first referenced. References are reported as warnings, since the referenced object may be created
+// by the same apply, and references that can't be checked are skipped.
+type ReferenceChecker struct {
+	client client.Invoker
+
+	integrations map[string][]client.IntegrationV4
+	permissions  map[string][]string
+	bundles      []client.BundleV2
+	accessScopes []client.AccessScopeV1
+	listed       map[string]bool
+}
+
+func NewReferenceChecker(apiClient client.Invoker) *ReferenceChecker {
+	return &ReferenceChecker{
+		client:       apiClient,
+		integrations: map[string][]client.IntegrationV4{},
+		permissions:  map[string][]string{},
+		listed:       map[string]bool{},
+	}
+}
+
+// CheckIntegrationTarget checks the integration, resource type and permissions of an integration access target.
+func (c *ReferenceChecker) CheckIntegrationTarget(ctx context.Context, targetPath path.Path, target *models.IntegrationTargetModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if target == nil {
+		return diags
+	}
+
+	integrations, ok := c.listIntegrations(ctx, common.ResourceCategory)
+	if !ok {
+		return diags
+	}
+
+	var integration *client.IntegrationV4
+	switch {
+	case isKnownReference(target.IntegrationID):
+		integration = findIntegration(integrations, func(i client.IntegrationV4) bool { return i.ID == target.IntegrationID.ValueString() })
+		if integration == nil {
+			diags.AddAttributeWarning(
+				targetPath.AtName("integration_id"),
+				"Unknown integration",
+				fmt.Sprintf("No resource integration with ID %q exists in the Apono tenant. Applying will fail unless it is created first.", target.IntegrationID.ValueString()),
+			)
+		}
+	case isKnownReference(target.IntegrationName):
+		name := target.IntegrationName.ValueString()
+		integration = findIntegration(integrations, func(i client.IntegrationV4) bool { return i.Name == name })
+		if integration == nil {
+			diags.AddAttributeWarning(
+				targetPath.AtName("integration_name"),
+				"Unknown integration",
+				fmt.Sprintf("No resource integration named %q exists in the Apono tenant.%s Applying will fail unless it is created first.",
+					name, common.DidYouMean(name, integrationNames(integrations))),
+			)
+		}
+	}
+
+	if integration == nil || !isKnownReference(target.ResourceType) {
+		return diags
+	}
+
+	// Integrations that haven't synced yet report no resource types.
+	resourceTypes, ok := integration.ConnectedResourceTypes.Get()
+	resourceType := target.ResourceType.ValueString()
+	if ok && len(resourceTypes) > 0 && !slices.Contains(resourceTypes, resourceType) {
+		diags.AddAttributeWarning(
+			targetPath.AtName("resource_type"),
+			"Unknown resource type",
+			fmt.Sprintf("Integration %q has no resource type %q.%s Applying will fail unless it is added to the integration first.",
+				integration.Name, resourceType, common.DidYouMean(resourceType, resourceTypes)),
+		)
+		return diags
+	}
+
+	diags.Append(c.checkPermissions(ctx, targetPath.AtName("permissions"), integration, resourceType, target.Permissions)...)
+
+	return diags
+}
+
+// checkPermissions checks the permissions of an integration target against the permissions the integration offers
+// for its resource type. The permissions are only listed when the target has known permissions.
+func (c *ReferenceChecker) checkPermissions(ctx context.Context, permissionsPath path.Path, integration *client.IntegrationV4, resourceType string, permissions types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var names []string
+	for _, permission := range permissions.Elements() {
+		if permission, ok := permission.(types.String); ok && isKnownReference(permission) {
+			names = append(names, permission.ValueString())
+		}
+	}
+	if len(names) == 0 {
+		return diags
+	}
+
+	available, ok := c.listPermissions(ctx, integration.ID, resourceType)
+	if !ok || len(available) == 0 {
+		return diags
+	}
+
+	for _, name := range names {
+		if slices.Contains(available, name) {
+			continue
+		}
+
+		diags.AddAttributeWarning(
+			permissionsPath.AtSetValue(types.StringValue(name)),
+			"Unknown permission",
+			fmt.Sprintf("Integration %q has no permission %q for resource type %q.%s Applying will fail unless the integration offers it.",
+				integration.Name, name, resourceType, common.DidYouMean(name, available)),
+		)
+	}
+
+	return diags
+}
+
+// CheckBundleTarget checks the bundle of a bundle access target.
+func (c *ReferenceChecker) CheckBundleTarget(ctx context.Context, targetPath path.Path, target *models.AccessFlowTargetBundleModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if target == nil || !c.listBundles(ctx) {
+		return diags
+	}
+
+	names := make([]string, 0, len(c.bundles))
+	ids := make([]string, 0, len(c.bundles))
+	for _, bundle := range c.bundles {
+		names = append(names, bundle.Name)
+		ids = append(ids, bundle.ID)
+	}
+
+	checkReference(targetPath, "bundle", "bundle_id", target.ID, ids, "name", target.Name, names, &diags)
+
+	return diags
+}
+
+// CheckAccessScopeTarget checks the access scope of an access scope target.
+func (c *ReferenceChecker) CheckAccessScopeTarget(ctx context.Context, targetPath path.Path, target *models.AccessScopeTargetModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if target == nil || !c.listAccessScopes(ctx) {
+		return diags
+	}
+
+	names := make([]string, 0, len(c.accessScopes))
+	ids := make([]string, 0, len(c.accessScopes))
+	for _, accessScope := range c.accessScopes {
+		names = append(names, accessScope.Name)
+		ids = append(ids, accessScope.ID)
+	}
+
+	checkReference(targetPath, "access scope", "access_scope_id", target.ID, ids, "name", target.Name, names, &diags)
+
+	return diags
+}
+
+// CheckConditions checks the source integrations of requestor or grantee conditions.
+func (c *ReferenceChecker) CheckConditions(ctx context.Context, conditionsPath path.Path, conditions []models.AccessFlowCondition) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for i, condition := range conditions {
+		if !isKnownReference(condition.SourceIntegrationName) {
+			continue
+		}
+
+		integrations, ok := c.listIntegrations(ctx, common.UserInformationCategory)
+		if !ok {
+			return diags
+		}
+
+		name := condition.SourceIntegrationName.ValueString()
+		if findIntegration(integrations, func(i client.IntegrationV4) bool { return i.Name == name }) != nil {
+			continue
+		}
+
+		diags.AddAttributeWarning(
+			conditionsPath.AtListIndex(i).AtName("source_integration_name"),
+			"Unknown integration",
+			fmt.Sprintf("No user information integration named %q exists in the Apono tenant.%s Applying will fail unless it is created first.",
+				name, common.DidYouMean(name, integrationNames(integrations))),
+		)
+	}
+
+	return diags
+}
+
+func (c *ReferenceChecker) listIntegrations(ctx context.Context, category string) ([]client.IntegrationV4, bool) {
+	key := "integrations:" + category
+	if !c.listed[key] {
+		c.listed[key] = true
+
+		integrations, err := ListIntegrations(ctx, c.client, "", "", "", []string{category})
+		if err != nil {
+			tflog.Warn(ctx, "Skipping integration reference checks", map[string]any{"error": err.Error()})
+			return nil, false
+		}
+		c.integrations[category] = integrations
+	}
+
+	integrations, ok := c.integrations[category]
+	return integrations, ok
+}
+
+// listPermissions lists the permissions of an integration for a resource type, once per integration and resource type.
+func (c *ReferenceChecker) listPermissions(ctx context.Context, integrationID string, resourceType string) ([]string, bool) {
+	key := "permissions:" + integrationID + ":" + resourceType
+	if !c.listed[key] {
+		c.listed[key] = true
+
+		permissions, err := ListIntegrationPermissions(ctx, c.client, integrationID, resourceType)
+		if err != nil {
+			tflog.Warn(ctx, "Skipping permission reference checks", map[string]any{"integration_id": integrationID, "error": err.Error()})
+			return nil, false
+		}
+		c.permissions[key] = permissions
+	}
+
+	permissions, ok := c.permissions[key]
+	return permissions, ok
+}
+
+func (c *ReferenceChecker) listBundles(ctx context.Context) bool {
+	if !c.listed["bundles"] {
+		c.listed["bundles"] = true
+
+		bundles, err := ListBundles(ctx, c.client, "")
+		if err != nil {
+			tflog.Warn(ctx, "Skipping bundle reference checks", map[string]any{"error": err.Error()})
+			return false
+		}
+		c.bundles = bundles
+	}
+
+	return c.bundles != nil
+}
+
+func (c *ReferenceChecker) listAccessScopes(ctx context.Context) bool {
+	if !c.listed["access_scopes"] {
+		c.listed["access_scopes"] = true
+
+		accessScopes, err := ListAccessScopesByName(ctx, c.client, "")
+		if err != nil {
+			tflog.Warn(ctx, "Skipping access scope reference checks", map[string]any{"error": err.Error()})
+			return false
+		}
+		c.accessScopes = accessScopes
+	}
+
+	return c.accessScopes != nil
+}
+
+// checkReference reports a reference by ID or, when the ID isn't set, by name that doesn't match any of the listed
+// objects of the given kind.
+func checkReference(targetPath path.Path, kind string, idAttr string, id types.String, ids []string, nameAttr string, name types.String, names []string, diags *diag.Diagnostics) {
+	switch {
+	case isKnownReference(id):
+		if !slices.Contains(ids, id.ValueString()) {
+			diags.AddAttributeWarning(
+				targetPath.AtName(idAttr),
+				"Unknown "+kind,
+				fmt.Sprintf("No %s with ID %q exists in the Apono tenant. Applying will fail unless it is created first.", kind, id.ValueString()),
+			)
+		}
+	case isKnownReference(name):
+		if !slices.Contains(names, name.ValueString()) {
+			diags.AddAttributeWarning(
+				targetPath.AtName(nameAttr),
+				"Unknown "+kind,
+				fmt.Sprintf("No %s named %q exists in the Apono tenant.%s Applying will fail unless it is created first.",
+					kind, name.ValueString(), common.DidYouMean(name.ValueString(), names)),
+			)
+		}
+	}
+}
+
+func findIntegration(integrations []client.IntegrationV4, match func(client.IntegrationV4) bool) *client.IntegrationV4 {
+	for i := range integrations {
+		if match(integrations[i]) {
+			return &integrations[i]
+		}
+	}
+
+	return nil
+}
+
+func integrationNames(integrations []client.IntegrationV4) []string {
+	names := make([]string, 0, len(integrations))
+	for _, integration := range integrations {
+		names = append(names, integration.Name)
+	}
+
+	return names
+}
+
+func isKnownReference(value types.String) bool {
+	return !value.IsNull() && !value.IsUnknown() && value.ValueString() != ""
+}
@@ -0,0 +1,222 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/mocks"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/models"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/testcommon"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func integrationsResponse(integrations ...client.IntegrationV4) *client.PublicApiListResponseIntegrationPublicV4Model {
+	return &client.PublicApiListResponseIntegrationPublicV4Model{Items: integrations}
+}
+
+func categoryParams(category string) client.ListIntegrationsV4Params {
+	params := client.ListIntegrationsV4Params{}
+	params.Category.SetTo([]string{category})
+	return params
+}
+
+func warningPaths(t *testing.T, diags diag.Diagnostics) []string {
+	require.False(t, diags.HasError())
+
+	paths := []string{}
+	for _, d := range diags.Warnings() {
+		paths = append(paths, d.(diag.DiagnosticWithPath).Path().String())
+	}
+	return paths
+}
+
+func TestReferenceCheckerIntegrationTarget(t *testing.T) {
+	ctx := t.Context()
+	mockInvoker := mocks.NewInvoker(t)
+
+	postgres := client.IntegrationV4{ID: "integration-1", Name: "postgresql-prod"}
+	postgres.ConnectedResourceTypes.SetTo([]string{"postgresql-database", "postgresql-table"})
+	unsynced := client.IntegrationV4{ID: "integration-2", Name: "mysql-prod"}
+
+	mockInvoker.EXPECT().ListIntegrationsV4(mock.Anything, categoryParams(common.ResourceCategory)).
+		Return(integrationsResponse(postgres, unsynced), nil).Once()
+
+	checker := NewReferenceChecker(mockInvoker)
+	targetPath := path.Root("access_targets").AtListIndex(0).AtName("integration")
+
+	diags := checker.CheckIntegrationTarget(ctx, targetPath, &models.IntegrationTargetModel{
+		IntegrationName: types.StringValue("postgresql-prod"),
+		IntegrationID:   types.StringNull(),
+		ResourceType:    types.StringValue("postgresql-database"),
+	})
+	assert.Empty(t, warningPaths(t, diags))
+
+	diags = checker.CheckIntegrationTarget(ctx, targetPath, &models.IntegrationTargetModel{
+		IntegrationName: types.StringValue("postgressql-prod"),
+		IntegrationID:   types.StringNull(),
+		ResourceType:    types.StringValue("postgresql-database"),
+	})
+	assert.Equal(t, []string{"access_targets[0].integration.integration_name"}, warningPaths(t, diags))
+	assert.Contains(t, diags[0].Detail(), `Did you mean "postgresql-prod"?`)
+
+	diags = checker.CheckIntegrationTarget(ctx, targetPath, &models.IntegrationTargetModel{
+		IntegrationName: types.StringNull(),
+		IntegrationID:   types.StringValue("integration-1"),
+		ResourceType:    types.StringValue("postgresql-databse"),
+	})
+	assert.Equal(t, []string{"access_targets[0].integration.resource_type"}, warningPaths(t, diags))
+	assert.Contains(t, diags[0].Detail(), `Did you mean "postgresql-database"?`)
+
+	diags = checker.CheckIntegrationTarget(ctx, targetPath, &models.IntegrationTargetModel{
+		IntegrationName: types.StringNull(),
+		IntegrationID:   types.StringValue("integration-3"),
+		ResourceType:    types.StringValue("postgresql-database"),
+	})
+	assert.Equal(t, []string{"access_targets[0].integration.integration_id"}, warningPaths(t, diags))
+
+	diags = checker.CheckIntegrationTarget(ctx, targetPath, &models.IntegrationTargetModel{
+		IntegrationName: types.StringValue("mysql-prod"),
+		IntegrationID:   types.StringNull(),
+		ResourceType:    types.StringValue("anything"),
+	})
+	assert.Empty(t, warningPaths(t, diags), "integrations without resource types are not checked")
+
+	diags = checker.CheckIntegrationTarget(ctx, targetPath, &models.IntegrationTargetModel{
+		IntegrationName: types.StringUnknown(),
+		IntegrationID:   types.StringNull(),
+		ResourceType:    types.StringValue("anything"),
+	})
+	assert.Empty(t, warningPaths(t, diags))
+}
+
+func TestReferenceCheckerIntegrationTargetPermissions(t *testing.T) {
+	ctx := t.Context()
+	mockInvoker := mocks.NewInvoker(t)
+
+	postgres := client.IntegrationV4{ID: "integration-1", Name: "postgresql-prod"}
+	postgres.ConnectedResourceTypes.SetTo([]string{"postgresql-database"})
+
+	permissionsParams := client.GetIntegrationPermissionsParams{ID: "integration-1"}
+	permissionsParams.ResourceType.SetTo("postgresql-database")
+
+	mockInvoker.EXPECT().ListIntegrationsV4(mock.Anything, categoryParams(common.ResourceCategory)).
+		Return(integrationsResponse(postgres), nil).Once()
+	mockInvoker.EXPECT().GetIntegrationPermissions(mock.Anything, permissionsParams).
+		Return(&client.PaginatedResponsePermissionV3Response{Data: []client.PermissionV3{
+			{ID: "permission-1", Name: "READ_ONLY", ResourceType: "postgresql-database"},
+			{ID: "permission-2", Name: "READ_WRITE", ResourceType: "postgresql-database"},
+			{ID: "permission-3", Name: "ADMIN", ResourceType: "postgresql-table"},
+		}}, nil).Once()
+
+	checker := NewReferenceChecker(mockInvoker)
+	targetPath := path.Root("access_targets").AtListIndex(0).AtName("integration")
+	target := func(permissions ...string) *models.IntegrationTargetModel {
+		return &models.IntegrationTargetModel{
+			IntegrationName: types.StringValue("postgresql-prod"),
+			IntegrationID:   types.StringNull(),
+			ResourceType:    types.StringValue("postgresql-database"),
+			Permissions:     testcommon.CreateTestStringSet(t, permissions),
+		}
+	}
+
+	diags := checker.CheckIntegrationTarget(ctx, targetPath, target("READ_ONLY", "READ_WRITE"))
+	assert.Empty(t, warningPaths(t, diags))
+
+	// Permissions are listed once per integration and resource type.
+	diags = checker.CheckIntegrationTarget(ctx, targetPath, target("READ_ONLY", "READ_WRTIE", "ADMIN"))
+	assert.ElementsMatch(t, []string{
+		`access_targets[0].integration.permissions[Value("READ_WRTIE")]`,
+		`access_targets[0].integration.permissions[Value("ADMIN")]`,
+	}, warningPaths(t, diags))
+	details := map[string]string{}
+	for _, d := range diags {
+		details[d.(diag.DiagnosticWithPath).Path().String()] = d.Detail()
+	}
+	assert.Contains(t, details[`access_targets[0].integration.permissions[Value("READ_WRTIE")]`], `Did you mean "READ_WRITE"?`)
+}
+
+func TestReferenceCheckerPermissionsListError(t *testing.T) {
+	ctx := t.Context()
+	mockInvoker := mocks.NewInvoker(t)
+
+	postgres := client.IntegrationV4{ID: "integration-1", Name: "postgresql-prod"}
+	mockInvoker.EXPECT().ListIntegrationsV4(mock.Anything, categoryParams(common.ResourceCategory)).
+		Return(integrationsResponse(postgres), nil).Once()
+	mockInvoker.EXPECT().GetIntegrationPermissions(mock.Anything, mock.Anything).
+		Return(nil, errors.New("forbidden")).Once()
+
+	checker := NewReferenceChecker(mockInvoker)
+	diags := checker.CheckIntegrationTarget(ctx, path.Root("integration"), &models.IntegrationTargetModel{
+		IntegrationName: types.StringValue("postgresql-prod"),
+		IntegrationID:   types.StringNull(),
+		ResourceType:    types.StringValue("postgresql-database"),
+		Permissions:     testcommon.CreateTestStringSet(t, []string{"READ_ONLY"}),
+	})
+	assert.Empty(t, warningPaths(t, diags), "permissions that can't be listed are not checked")
+}
+
+func TestReferenceCheckerBundleAndAccessScopeTargets(t *testing.T) {
+	ctx := t.Context()
+	mockInvoker := mocks.NewInvoker(t)
+
+	mockInvoker.EXPECT().ListBundlesV2(mock.Anything, client.ListBundlesV2Params{}).
+		Return(&client.PublicApiListResponseBundlePublicV2Model{Items: []client.BundleV2{{ID: "bundle-1", Name: "QA ENV"}}}, nil).Once()
+	mockInvoker.EXPECT().ListAccessScopesV1(mock.Anything, client.ListAccessScopesV1Params{}).
+		Return(&client.PublicApiListResponseAccessScopePublicV1Model{Items: []client.AccessScopeV1{{ID: "scope-1", Name: "Production Databases"}}}, nil).Once()
+
+	checker := NewReferenceChecker(mockInvoker)
+	targetPath := path.Root("access_targets").AtListIndex(1)
+
+	diags := checker.CheckBundleTarget(ctx, targetPath.AtName("bundle"), &models.AccessFlowTargetBundleModel{Name: types.StringValue("QA EVN"), ID: types.StringNull()})
+	assert.Equal(t, []string{"access_targets[1].bundle.name"}, warningPaths(t, diags))
+	assert.Contains(t, diags[0].Detail(), `Did you mean "QA ENV"?`)
+
+	diags = checker.CheckBundleTarget(ctx, targetPath.AtName("bundle"), &models.AccessFlowTargetBundleModel{Name: types.StringNull(), ID: types.StringValue("bundle-1")})
+	assert.Empty(t, warningPaths(t, diags))
+
+	diags = checker.CheckAccessScopeTarget(ctx, targetPath.AtName("access_scope"), &models.AccessScopeTargetModel{Name: types.StringValue("Staging Databases"), ID: types.StringNull()})
+	assert.Equal(t, []string{"access_targets[1].access_scope.name"}, warningPaths(t, diags))
+
+	diags = checker.CheckAccessScopeTarget(ctx, targetPath.AtName("access_scope"), &models.AccessScopeTargetModel{Name: types.StringNull(), ID: types.StringValue("scope-2")})
+	assert.Equal(t, []string{"access_targets[1].access_scope.access_scope_id"}, warningPaths(t, diags))
+
+	assert.Empty(t, checker.CheckBundleTarget(ctx, targetPath.AtName("bundle"), nil))
+}
+
+func TestReferenceCheckerConditions(t *testing.T) {
+	ctx := t.Context()
+	mockInvoker := mocks.NewInvoker(t)
+
+	mockInvoker.EXPECT().ListIntegrationsV4(mock.Anything, categoryParams(common.UserInformationCategory)).
+		Return(integrationsResponse(client.IntegrationV4{ID: "okta-1", Name: "Okta Directory"}), nil).Once()
+
+	checker := NewReferenceChecker(mockInvoker)
+
+	diags := checker.CheckConditions(ctx, path.Root("requestors").AtName("conditions"), []models.AccessFlowCondition{
+		{SourceIntegrationName: types.StringNull(), Type: types.StringValue("user")},
+		{SourceIntegrationName: types.StringValue("Okta Directory"), Type: types.StringValue("group")},
+		{SourceIntegrationName: types.StringValue("Okta Directry"), Type: types.StringValue("group")},
+	})
+	assert.Equal(t, []string{"requestors.conditions[2].source_integration_name"}, warningPaths(t, diags))
+	assert.Contains(t, diags[0].Detail(), `Did you mean "Okta Directory"?`)
+}
+
+func TestReferenceCheckerListError(t *testing.T) {
+	ctx := t.Context()
+	mockInvoker := mocks.NewInvoker(t)
+
+	mockInvoker.EXPECT().ListBundlesV2(mock.Anything, client.ListBundlesV2Params{}).Return(nil, errors.New("forbidden")).Once()
+
+	checker := NewReferenceChecker(mockInvoker)
+	target := &models.AccessFlowTargetBundleModel{Name: types.StringValue("missing"), ID: types.StringNull()}
+
+	assert.Empty(t, checker.CheckBundleTarget(ctx, path.Root("bundle"), target))
+	assert.Empty(t, checker.CheckBundleTarget(ctx, path.Root("bundle"), target))
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/common"
+)
+
+// ListUsers retrieves all Apono users.
+func ListUsers(ctx context.Context, apiClient client.Invoker) ([]client.UserV3, error) {
+	allUsers := []client.UserV3{}
+	pageToken := ""
+
+	for {
+		params := client.ListUsersV3Params{}
+		if pageToken != "" {
+			params.PageToken.SetTo(pageToken)
+		}
+
+		resp, err := apiClient.ListUsersV3(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list users: %w", err)
+		}
+
+		allUsers = append(allUsers, resp.Items...)
+
+		if resp.Pagination.NextPageToken.Value == "" {
+			break
+		}
+
+		pageToken = resp.Pagination.NextPageToken.Value
+	}
+
+	// Sort users by id for consistency
+	sort.Slice(allUsers, func(i, j int) bool {
+		return allUsers[i].ID < allUsers[j].ID
+	})
+
+	return allUsers, nil
+}
+
+// UserDirectory looks up Apono users by their primary email or any of their email aliases, ignoring case.
+type UserDirectory struct {
+	usersByEmail map[string]*client.UserV3
+	activeEmails []string
+}
+
+// NewUserDirectory indexes users by their emails. When an email belongs to more than one user, active users take
+// precedence.
+func NewUserDirectory(users []client.UserV3) *UserDirectory {
+	directory := &UserDirectory{usersByEmail: map[string]*client.UserV3{}}
+	for i := range users {
+		user := &users[i]
+		if user.Active {
+			directory.activeEmails = append(directory.activeEmails, user.Email)
+		}
+
+		for _, email := range append([]string{user.Email}, user.EmailAliases...) {
+			normalized := common.NormalizeEmail(email)
+			if existing, ok := directory.usersByEmail[normalized]; !ok || (!existing.Active && user.Active) {
+				directory.usersByEmail[normalized] = user
+			}
+		}
+	}
+
+	return directory
+}
+
+// FindByEmail returns the user with the given primary email or email alias.
+func (d *UserDirectory) FindByEmail(email string) (*client.UserV3, bool) {
+	user, ok := d.usersByEmail[common.NormalizeEmail(email)]
+	return user, ok
+}
+
+// PrimaryEmail returns the normalized primary email of the user with the given email, which may be an alias, or the
+// normalized email itself when no user has it.
+func (d *UserDirectory) PrimaryEmail(email string) string {
+	if user, ok := d.FindByEmail(email); ok {
+		return common.NormalizeEmail(user.Email)
+	}
+
+	return common.NormalizeEmail(email)
+}
+
+// MemberProblem returns the reason why the given email can't be used as a group member: no user has it, or its user
+// isn't active. It returns an empty string when the email belongs to an active user.
+func (d *UserDirectory) MemberProblem(email string) string {
+	user, ok := d.FindByEmail(email)
+	if !ok {
+		return fmt.Sprintf("No Apono user has the email %q.%s", email, common.DidYouMean(email, d.activeEmails))
+	}
+
+	if !user.Active {
+		return fmt.Sprintf("The Apono user with the email %q is not active.", email)
+	}
+
+	return ""
+}
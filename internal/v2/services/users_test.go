@@ -0,0 +1,60 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/client"
+	"github.com/apono-io/terraform-provider-apono/internal/v2/api/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListUsers(t *testing.T) {
+	ctx := t.Context()
+	mockInvoker := mocks.NewInvoker(t)
+
+	firstPage := &client.PublicApiListResponseUserPublicV3Model{
+		Items: []client.UserV3{{ID: "user-2", Email: "jane@example.com"}},
+	}
+	firstPage.Pagination.NextPageToken.SetTo("next")
+	secondParams := client.ListUsersV3Params{}
+	secondParams.PageToken.SetTo("next")
+
+	mockInvoker.EXPECT().ListUsersV3(mock.Anything, client.ListUsersV3Params{}).Return(firstPage, nil).Once()
+	mockInvoker.EXPECT().ListUsersV3(mock.Anything, secondParams).Return(&client.PublicApiListResponseUserPublicV3Model{
+		Items: []client.UserV3{{ID: "user-1", Email: "john@example.com"}},
+	}, nil).Once()
+
+	users, err := ListUsers(ctx, mockInvoker)
+
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, "user-1", users[0].ID)
+	assert.Equal(t, "user-2", users[1].ID)
+}
+
+func TestUserDirectory(t *testing.T) {
+	directory := NewUserDirectory([]client.UserV3{
+		{ID: "user-1", Email: "john@example.com", Active: true},
+		{ID: "user-2", Email: "Jane@example.com", EmailAliases: []string{"jane.doe@example.com"}, Active: true},
+		{ID: "user-3", Email: "bob@example.com"},
+		{ID: "user-4", Email: "robert@example.com", EmailAliases: []string{"bob@example.com"}, Active: true},
+		{ID: "user-5", Email: "alice@example.com"},
+	})
+
+	user, ok := directory.FindByEmail("JANE.DOE@example.com")
+	require.True(t, ok)
+	assert.Equal(t, "user-2", user.ID)
+
+	user, ok = directory.FindByEmail("bob@example.com")
+	require.True(t, ok)
+	assert.Equal(t, "user-4", user.ID, "active users take precedence")
+
+	assert.Equal(t, "jane@example.com", directory.PrimaryEmail("Jane.Doe@example.com"))
+	assert.Equal(t, "unknown@example.com", directory.PrimaryEmail("Unknown@example.com"))
+
+	assert.Empty(t, directory.MemberProblem("jane.doe@example.com"))
+	assert.Equal(t, `The Apono user with the email "alice@example.com" is not active.`, directory.MemberProblem("alice@example.com"))
+	assert.Equal(t, `No Apono user has the email "jonh@example.com". Did you mean "john@example.com"?`, directory.MemberProblem("jonh@example.com"))
+}